@@ -0,0 +1,60 @@
+package sticker
+
+// WithAck makes the ticker hold back ticks on C while n delivered ticks have not been acknowledged
+// by [ScheduledTicker.Ack], e.g. to bound the work in flight for at-least-once processing. Ticks that
+// are due while the limit is reached are coalesced into the latest of them, which is delivered as soon
+// as a tick is acknowledged. The others count as dropped. Subscribers are not affected.
+// A non-positive n disables acknowledgements.
+func WithAck(n int) Option {
+	return func(st *ScheduledTicker) {
+		if n > 0 {
+			st.ackLimit = uint64(n)
+		}
+	}
+}
+
+// Ack acknowledges the processing of a tick received from C of a ticker created with WithAck.
+// If a tick was held back it is delivered right away. Ack does nothing if no tick is unacknowledged.
+func (st *ScheduledTicker) Ack() {
+	if st == nil {
+		return
+	}
+	st.mu.Lock()
+	if st.inflight > 0 {
+		st.inflight--
+	}
+	t := st.held
+	if t == nil || st.inflight >= st.ackLimit {
+		st.mu.Unlock()
+		return
+	}
+	st.held = nil
+	st.inflight++
+	st.mu.Unlock()
+
+	delivered := st.deliver(*t, false)
+	st.mu.Lock()
+	st.account(*t, delivered)
+	st.mu.Unlock()
+}
+
+// holdForAck reports whether t is held back because the limit of unacknowledged ticks is reached.
+// If not, a slot is reserved for t that is released if it cannot be delivered.
+func (st *ScheduledTicker) holdForAck(t Tick) bool {
+	if st.ackLimit == 0 {
+		return false
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if st.inflight < st.ackLimit {
+		st.inflight++
+		return false
+	}
+	if st.held != nil {
+		// Coalesce the held tick into t.
+		st.dropped++
+		st.stats.Dropped++
+	}
+	st.held = &t
+	return true
+}
@@ -0,0 +1,48 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAck(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	dt := NewDetailed(first, interval, WithAck(1), WithClock(fc))
+	defer dt.Stop()
+
+	advance := func(i int) {
+		t.Helper()
+		next := first.Add(time.Duration(i) * interval)
+		fc.expectTimer(t, next)
+		fc.Set(next)
+	}
+
+	advance(0)
+	if tick := receive(t, dt.C); tick.Seq != 1 {
+		t.Errorf("expected tick 1, but got %+v", tick)
+	}
+
+	// The consumer is slow to acknowledge, so the next ticks are held back and coalesced.
+	advance(1)
+	advance(2)
+	fc.expectTimer(t, first.Add(3*interval))
+	expectNothing(t, dt.C)
+
+	dt.Ack()
+	if tick := receive(t, dt.C); tick.Seq != 3 || !tick.Scheduled.Equal(first.Add(2*interval)) {
+		t.Errorf("expected held back tick 3, but got %+v", tick)
+	}
+	expectNothing(t, dt.C)
+
+	dt.Ack()
+	advance(3)
+	if tick := receive(t, dt.C); tick.Seq != 4 {
+		t.Errorf("expected tick 4, but got %+v", tick)
+	}
+	fc.expectTimer(t, first.Add(4*interval))
+	if s, want := dt.Stats(), (Stats{Delivered: 3, Dropped: 1}); s != want {
+		t.Errorf("expected %+v, but got %+v", want, s)
+	}
+}
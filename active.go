@@ -0,0 +1,14 @@
+package sticker
+
+import "sync/atomic"
+
+// active is the number of tickers whose goroutine is running.
+var active atomic.Int64
+
+// ActiveTickers returns the number of tickers that are currently running, i.e. that were created and
+// whose goroutine did not yet exit after Stop or the end of their context. A number that keeps growing
+// hints at tickers that are never stopped. Tickers created by NewManual or NewManualTicker and the tickers of
+// a Pool have no goroutine of their own and are not counted; see Pool.Len for the latter.
+func ActiveTickers() int {
+	return int(active.Load())
+}
@@ -0,0 +1,53 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+// settledActiveTickers returns the number of active tickers once goroutines of earlier tests
+// that are still exiting are gone.
+func settledActiveTickers(t *testing.T) int {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for n := ActiveTickers(); ; {
+		time.Sleep(20 * time.Millisecond)
+		m := ActiveTickers()
+		if m == n {
+			return n
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("number of active tickers did not settle, last %d", m)
+		}
+		n = m
+	}
+}
+
+func TestActiveTickers(t *testing.T) {
+	before := settledActiveTickers(t)
+	tickers := []*ScheduledTicker{
+		New(time.Now(), time.Hour),
+		NewDetailed(time.Now(), time.Hour).ScheduledTicker,
+		NewEveryMinute(),
+		FromTimeTicker(time.NewTicker(time.Hour), time.Time{}),
+	}
+	// Neither manual tickers nor those of a pool have a goroutine of their own.
+	NewManual(time.Now(), time.Hour).Stop()
+	p := NewPool()
+	defer p.Close()
+	p.New(time.Now(), time.Hour)
+	if running := ActiveTickers(); running != before+len(tickers) {
+		t.Fatalf("expected %d active tickers, but got %d", before+len(tickers), running)
+	}
+
+	for _, st := range tickers {
+		st.Stop()
+	}
+	deadline := time.Now().Add(time.Second)
+	for ActiveTickers() != before {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %d active tickers after Stop like before, but got %d", before, ActiveTickers())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
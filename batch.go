@@ -0,0 +1,97 @@
+package sticker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// BatchedTicker is a ScheduledTicker that delivers its ticks in batches, see NewBatched.
+type BatchedTicker struct {
+	*ScheduledTicker
+
+	C <-chan []time.Time // The channel on which the batches of ticks are delivered.
+}
+
+// NewBatched returns a new BatchedTicker that ticks at time first in the interval fine but delivers the
+// ticks on C only once per interval flush, e.g. to batch writes while keeping the time of every tick.
+// The ticks are collected in windows of length flush starting at first. Each batch holds the ticks of a
+// window and is delivered with the last tick of the window, so flush should be a multiple of fine.
+// A batch is never dropped: if the previous one was not received yet, both are merged in order.
+// Reset changes the fine interval, while the windows stay aligned to first.
+// The durations fine and flush must be greater than zero; if not, NewBatched will panic.
+// Stop the ticker to release associated resources.
+func NewBatched(first time.Time, fine, flush time.Duration, opts ...Option) *BatchedTicker {
+	if fine <= 0 || flush <= 0 {
+		panic(errors.New("non-positive interval for NewBatched BatchedTicker"))
+	}
+	c := make(chan []time.Time, 1)
+	ticker := &BatchedTicker{
+		ScheduledTicker: newTicker(context.Background(), opts),
+		C:               c,
+	}
+	// Heartbeats could not be told apart from ticks in a batch.
+	ticker.heartbeat = 0
+	now := ticker.clock.Now()
+	if first.IsZero() {
+		first = now
+	}
+	b := &batcher{
+		st:      ticker.ScheduledTicker,
+		c:       c,
+		first:   first,
+		flush:   flush,
+		flushAt: NextRun(first, flush, laterOf(now, first)),
+	}
+	ticker.deliver = b.add
+	if ticker.closeOnStop {
+		ticker.closeWhenStopped(func() { close(c) })
+	}
+	ticker.start(first, fine)
+	return ticker
+}
+
+// batcher collects ticks and delivers them in batches.
+type batcher struct {
+	st    *ScheduledTicker
+	c     chan []time.Time
+	first time.Time
+	flush time.Duration
+
+	mu      sync.Mutex
+	batch   []time.Time
+	flushAt time.Time // The end of the current window.
+}
+
+// add adds t to the current batch and delivers the batch if t is the last tick of its window.
+// Batches are never dropped, so it always reports success.
+func (b *batcher) add(t Tick, _ bool) bool {
+	b.st.mu.Lock()
+	interval := b.st.interval
+	b.st.mu.Unlock()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.batch = append(b.batch, t.stamp())
+	if t.Scheduled.Add(interval).Before(b.flushAt) {
+		return true
+	}
+	// The next window starts at the end of the one of t.
+	b.flushAt = NextRun(b.first, b.flush, NextRun(b.first, b.flush, t.Scheduled))
+	batch := b.batch
+	b.batch = nil
+	for {
+		select {
+		case b.c <- batch:
+			return true
+		default:
+		}
+		// Merge with the batch that was not received yet.
+		select {
+		case pending := <-b.c:
+			batch = append(pending, batch...)
+		default:
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBatched(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fine, flush := time.Minute, 5*time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	bt := NewBatched(first, fine, flush, WithClock(fc))
+	defer bt.Stop()
+
+	for n := 0; n < 2; n++ {
+		for i := 0; i < 5; i++ {
+			next := first.Add(time.Duration(5*n+i) * fine)
+			fc.expectTimer(t, next)
+			fc.Set(next)
+			if i < 4 {
+				expectNothing(t, bt.C)
+			}
+		}
+		batch := receive(t, bt.C)
+		if len(batch) != 5 {
+			t.Fatalf("expected batch of 5 ticks, but got %v", batch)
+		}
+		for i, tick := range batch {
+			if want := first.Add(time.Duration(5*n+i) * fine); !tick.Equal(want) {
+				t.Errorf("expected tick %d of batch at %v, but got %v", i, want, tick)
+			}
+		}
+	}
+}
+
+func TestBatchedMerge(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fine, flush := time.Minute, 2*time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	bt := NewBatched(first, fine, flush, WithClock(fc))
+	defer bt.Stop()
+
+	// Two batches are due before the first one is received.
+	for i := 0; i < 4; i++ {
+		next := first.Add(time.Duration(i) * fine)
+		fc.expectTimer(t, next)
+		fc.Set(next)
+	}
+	fc.expectTimer(t, first.Add(4*fine))
+	if batch := receive(t, bt.C); len(batch) != 4 {
+		t.Errorf("expected merged batch of 4 ticks, but got %v", batch)
+	}
+	if s := bt.Stats(); s.Delivered != 4 || s.Dropped != 0 {
+		t.Errorf("expected 4 ticks delivered, but got %+v", s)
+	}
+}
+
+func TestBatchedHeartbeat(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fine, flush := time.Minute, 2*time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	bt := NewBatched(first, fine, flush, WithHeartbeat(time.Second), WithClock(fc))
+	defer bt.Stop()
+
+	// No heartbeat is due before the ticks.
+	for i := 0; i < 2; i++ {
+		next := first.Add(time.Duration(i) * fine)
+		fc.expectTimer(t, next)
+		fc.Set(next)
+	}
+	if batch := receive(t, bt.C); len(batch) != 2 {
+		t.Errorf("expected batch of 2 ticks without heartbeats, but got %v", batch)
+	}
+}
@@ -0,0 +1,111 @@
+package sticker
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// NewBusinessDays returns a new ScheduledTicker that ticks every n business days starting at first,
+// e.g. every 3 business days for financial jobs. Business days are Monday to Friday in loc except for
+// holidays. For holidays only their calendar date in their own location is used. Every tick happens at
+// the time of day of first in loc. If first is not a business day the first tick is on the next one.
+// A zero first means now. n must be greater than zero and loc must not be nil; if not, NewBusinessDays
+// will panic. Stop the ticker to release associated resources.
+func NewBusinessDays(first time.Time, n int, holidays []time.Time, loc *time.Location, opts ...Option) *ScheduledTicker {
+	if n <= 0 {
+		panic(errors.New("non-positive n for NewBusinessDays ScheduledTicker"))
+	}
+	if loc == nil {
+		panic(errors.New("nil location for NewBusinessDays ScheduledTicker"))
+	}
+	ticker := newChanTicker(context.Background(), opts)
+	if first.IsZero() {
+		first = ticker.clock.Now()
+	}
+	b := &businessDays{
+		n:        n,
+		holidays: make(map[date]bool, len(holidays)),
+		loc:      loc,
+	}
+	for _, h := range holidays {
+		b.holidays[dateOf(h)] = true
+	}
+	b.first = b.roll(first.In(loc), 0)
+	ticker.startSchedule(b, time.Duration(n)*day)
+	return ticker
+}
+
+// date is a calendar date.
+type date struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+func dateOf(t time.Time) date {
+	y, m, d := t.Date()
+	return date{y, m, d}
+}
+
+// businessDays is the schedule of a tick every n business days.
+type businessDays struct {
+	first    time.Time // The first tick which is on a business day.
+	n        int
+	holidays map[date]bool
+	loc      *time.Location
+}
+
+func (b *businessDays) next(t time.Time) time.Time {
+	p := b.first
+	for !p.After(t) {
+		p = b.roll(p, b.n)
+	}
+	return p
+}
+
+func (b *businessDays) prev(t time.Time) time.Time {
+	if t.Before(b.first) {
+		return time.Time{}
+	}
+	p := b.first
+	for {
+		next := b.roll(p, b.n)
+		if next.After(t) {
+			return p
+		}
+		p = next
+	}
+}
+
+// roll returns the point in time n business days after the day of p at the time of day of first.
+// With n zero it returns p itself if it is on a business day or the next business day otherwise.
+func (b *businessDays) roll(p time.Time, n int) time.Time {
+	y, m, d := p.Date()
+	// NOTE: days are checked at noon since time.Date normalizes d beyond the end of the month.
+	isBusinessDay := func() bool {
+		return b.isBusinessDay(time.Date(y, m, d, 12, 0, 0, 0, b.loc))
+	}
+	for !isBusinessDay() {
+		d++
+	}
+	for ; n > 0; n-- {
+		d++
+		for !isBusinessDay() {
+			d++
+		}
+	}
+	// The time of day of first or, while first is being determined, of p itself.
+	ref := p
+	if !b.first.IsZero() {
+		ref = b.first
+	}
+	return time.Date(y, m, d, ref.Hour(), ref.Minute(), ref.Second(), ref.Nanosecond(), b.loc)
+}
+
+func (b *businessDays) isBusinessDay(t time.Time) bool {
+	if wd := t.Weekday(); wd == time.Saturday || wd == time.Sunday {
+		return false
+	}
+	return !b.holidays[dateOf(t)]
+}
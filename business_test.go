@@ -0,0 +1,51 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBusinessDays(t *testing.T) {
+	// 2023-12-14 is a Thursday.
+	first := time.Date(2023, 12, 14, 9, 30, 0, 0, time.UTC)
+	holidays := []time.Time{
+		time.Date(2023, 12, 19, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 12, 26, 0, 0, 0, 0, time.UTC),
+	}
+	fc := newFakeClock(first.Add(-time.Hour))
+	st := NewBusinessDays(first, 2, holidays, time.UTC, WithClock(fc))
+	defer st.Stop()
+
+	for _, want := range []time.Time{
+		first,
+		// Crossing a weekend.
+		time.Date(2023, 12, 18, 9, 30, 0, 0, time.UTC),
+		// Crossing a holiday.
+		time.Date(2023, 12, 21, 9, 30, 0, 0, time.UTC),
+		// Crossing a weekend followed by two holidays.
+		time.Date(2023, 12, 27, 9, 30, 0, 0, time.UTC),
+	} {
+		fc.expectTimer(t, want)
+		fc.Set(want)
+		if tick := receive(t, st.C); !tick.Equal(want) {
+			t.Errorf("expected tick at %v, but got %v", want, tick)
+		}
+	}
+	fc.Set(time.Date(2023, 12, 28, 12, 0, 0, 0, time.UTC))
+	if last, want := st.LastBoundary(), time.Date(2023, 12, 27, 9, 30, 0, 0, time.UTC); !last.Equal(want) {
+		t.Errorf("expected last boundary %v, but got %v", want, last)
+	}
+}
+
+func TestBusinessDaysFirstOnWeekend(t *testing.T) {
+	// 2023-12-16 is a Saturday.
+	first := time.Date(2023, 12, 16, 8, 0, 0, 0, time.UTC)
+	fc := newFakeClock(first.Add(-time.Hour))
+	st := NewBusinessDays(first, 1, nil, time.UTC, WithClock(fc))
+	defer st.Stop()
+
+	if next, want := st.NextTick(), time.Date(2023, 12, 18, 8, 0, 0, 0, time.UTC); !next.Equal(want) {
+		t.Errorf("expected first tick at %v, but got %v", want, next)
+	}
+}
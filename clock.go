@@ -0,0 +1,65 @@
+package sticker
+
+import (
+	"errors"
+	"time"
+)
+
+// Clock is the source of time of a ScheduledTicker. Tests and simulations can provide their own
+// implementation with WithClock to control time, e.g. to test code depending on a schedule deterministically.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// NewTimer returns a new active Timer that fires after the duration d like [time.NewTimer].
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer is the subset of [time.Timer] used by a ScheduledTicker. Its methods have the semantics
+// of the ones of [time.Timer], except that C returns the channel on which the time is delivered.
+type Timer interface {
+	C() <-chan time.Time
+	Stop() bool
+	Reset(d time.Duration) bool
+}
+
+// WithClock makes the ticker use c as its source of time instead of the system clock. The ticker
+// reads the current time from c and waits for its ticks with timers of c. It has no effect on
+// tickers created by NewManual, whose time is set by Tick. c must not be nil; if it is, WithClock will panic.
+func WithClock(c Clock) Option {
+	if c == nil {
+		panic(errors.New("nil clock for WithClock"))
+	}
+	return func(st *ScheduledTicker) {
+		st.clock = c
+	}
+}
+
+// realClock is the clock backed by package time.
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) NewTimer(d time.Duration) Timer {
+	return realTimer{time.NewTimer(d)}
+}
+
+type realTimer struct {
+	*time.Timer
+}
+
+func (t realTimer) C() <-chan time.Time {
+	return t.Timer.C
+}
+
+// offsetClock is a clock that is off by a fixed offset from another clock.
+type offsetClock struct {
+	Clock
+	offset time.Duration
+}
+
+func (c offsetClock) Now() time.Time {
+	return c.Clock.Now().Add(c.offset)
+}
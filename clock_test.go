@@ -0,0 +1,196 @@
+package sticker
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock is a clock that only moves when told to.
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*fakeTimer
+}
+
+func newFakeClock(now time.Time) *fakeClock {
+	return &fakeClock{now: now}
+}
+
+func (fc *fakeClock) Now() time.Time {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	return fc.now
+}
+
+func (fc *fakeClock) NewTimer(d time.Duration) Timer {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	ft := &fakeTimer{
+		clock:  fc,
+		c:      make(chan time.Time, 1),
+		when:   fc.now.Add(d),
+		active: true,
+	}
+	fc.timers = append(fc.timers, ft)
+	fc.fire()
+	return ft
+}
+
+// Advance moves the clock forward by d and fires all timers that became due.
+func (fc *fakeClock) Advance(d time.Duration) {
+	fc.Set(fc.Now().Add(d))
+}
+
+// Set moves the clock to now, which may also be in the past, and fires all timers that became due.
+func (fc *fakeClock) Set(now time.Time) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	fc.now = now
+	fc.fire()
+}
+
+// fire sends on all active timers that are due. fc.mu must be held.
+func (fc *fakeClock) fire() {
+	for _, ft := range fc.timers {
+		if ft.active && !ft.when.After(fc.now) {
+			ft.active = false
+			select {
+			case ft.c <- fc.now:
+			default:
+			}
+		}
+	}
+}
+
+// awaitTimer waits until a timer is armed and returns when it is due.
+func (fc *fakeClock) awaitTimer(t *testing.T) time.Time {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		fc.mu.Lock()
+		for _, ft := range fc.timers {
+			if ft.active {
+				when := ft.when
+				fc.mu.Unlock()
+				return when
+			}
+		}
+		fc.mu.Unlock()
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatal("no timer armed")
+	return time.Time{}
+}
+
+// expectTimer waits until a timer is armed to be due at want or fails the test.
+func (fc *fakeClock) expectTimer(t *testing.T, want time.Time) {
+	t.Helper()
+	var due []time.Time
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		due = due[:0]
+		fc.mu.Lock()
+		for _, ft := range fc.timers {
+			if ft.active {
+				due = append(due, ft.when)
+			}
+		}
+		fc.mu.Unlock()
+		for _, d := range due {
+			if d.Equal(want) {
+				return
+			}
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("expected timer armed until %v, but got %v", want, due)
+}
+
+// expectNoTimer waits until no timer is armed anymore or fails the test.
+func (fc *fakeClock) expectNoTimer(t *testing.T) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		armed := false
+		fc.mu.Lock()
+		for _, ft := range fc.timers {
+			armed = armed || ft.active
+		}
+		fc.mu.Unlock()
+		if !armed {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatal("expected no timer to be armed")
+}
+
+type fakeTimer struct {
+	clock  *fakeClock
+	c      chan time.Time
+	when   time.Time
+	active bool
+}
+
+func (ft *fakeTimer) C() <-chan time.Time {
+	return ft.c
+}
+
+func (ft *fakeTimer) Stop() bool {
+	ft.clock.mu.Lock()
+	defer ft.clock.mu.Unlock()
+	wasActive := ft.active
+	ft.active = false
+	return wasActive
+}
+
+func (ft *fakeTimer) Reset(d time.Duration) bool {
+	ft.clock.mu.Lock()
+	defer ft.clock.mu.Unlock()
+	wasActive := ft.active
+	ft.when = ft.clock.now.Add(d)
+	ft.active = true
+	ft.clock.fire()
+	return wasActive
+}
+
+// receive waits for a value on c or fails the test.
+func receive[T any](t *testing.T, c <-chan T) T {
+	t.Helper()
+	select {
+	case v := <-c:
+		return v
+	case <-time.After(time.Second):
+		t.Fatal("nothing received")
+	}
+	var zero T
+	return zero
+}
+
+// expectNothing fails the test if c receives a value within a short time.
+func expectNothing[T any](t *testing.T, c <-chan T) {
+	t.Helper()
+	select {
+	case v := <-c:
+		t.Fatalf("unexpectedly received %v", v)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestWithClock(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(now)
+	st := New(now.Add(time.Minute), time.Minute, WithClock(fc))
+	defer st.Stop()
+	if until, want := st.Until(), time.Minute; until != want {
+		t.Errorf("expected next tick in %v on the fake clock, but got %v", want, until)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for nil clock")
+		}
+	}()
+	WithClock(nil)
+}
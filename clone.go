@@ -0,0 +1,60 @@
+package sticker
+
+// Clone returns a new ticker with the current schedule and the options of st, e.g. to create tickers
+// for several workers from a template. The clone is independent of st: it has its own channel and
+// goroutine, or belongs to the same Pool as st, and is not affected by Reset or Stop of st. It starts
+// as if it was created with the current schedule of st, but without a pending Swap. The clone delivers
+// its ticks on C, even if st was created by NewToSink, except for a ticker created by NewFunc: its clone
+// calls the same function in goroutines of its own and its C is nil, too. A nil ticker is cloned to nil.
+func (st *ScheduledTicker) Clone() *ScheduledTicker {
+	if st == nil {
+		return nil
+	}
+	clone := newChanTicker(st.parent, st.opts)
+	if st.fn == nil {
+		st.startClone(clone)
+		return clone
+	}
+	c := clone.detach(st.fn)
+	st.startClone(clone)
+	clone.callEach(c)
+	return clone
+}
+
+// Clone is like [ScheduledTicker.Clone] but returns a DetailedTicker.
+func (dt *DetailedTicker) Clone() *DetailedTicker {
+	if dt == nil {
+		return nil
+	}
+	clone := newDetailed(dt.parent, dt.opts)
+	dt.ScheduledTicker.startClone(clone.ScheduledTicker)
+	return clone
+}
+
+// startClone starts clone with the current schedule of st.
+func (st *ScheduledTicker) startClone(clone *ScheduledTicker) {
+	st.mu.Lock()
+	first, interval, sched := st.first, st.interval, st.sched
+	st.mu.Unlock()
+
+	if st.manual {
+		clone.manual = true
+		clone.clock = &manualClock{now: st.clock.Now()}
+		clone.mu.Lock()
+		if sched != nil {
+			clone.setCustomSchedule(clone.clock.Now(), sched, interval)
+		} else {
+			clone.setSchedule(clone.clock.Now(), first, interval, clone.missed)
+		}
+		clone.mu.Unlock()
+		return
+	}
+	if st.pool != nil {
+		st.pool.join(clone)
+	}
+	if sched != nil {
+		clone.startSchedule(sched, interval)
+		return
+	}
+	clone.start(first, interval)
+}
@@ -0,0 +1,93 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClone(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	st := New(first.Add(-time.Hour), time.Hour, WithLocation(time.Local), WithClock(fc))
+	st.Reset(first, interval)
+
+	clone := st.Clone()
+	defer clone.Stop()
+	if clone.C == st.C {
+		t.Fatal("expected clone to have its own channel")
+	}
+	if clone.ConfigChanged(Config{FirstStart: first, Interval: interval}) {
+		t.Error("expected clone to have the current schedule")
+	}
+	st.Stop()
+
+	for i := 0; i < 2; i++ {
+		next := first.Add(time.Duration(i) * interval)
+		fc.expectTimer(t, next)
+		fc.Set(next)
+		tick := receive(t, clone.C)
+		if !tick.Equal(next) {
+			t.Errorf("expected tick at %v, but got %v", next, tick)
+		}
+		if tick.Location() != time.Local {
+			t.Errorf("expected tick in the location of the original, but got %v", tick.Location())
+		}
+	}
+	expectNothing(t, st.C)
+}
+
+func TestCloneDetailed(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(first.Add(-time.Second))
+	dt := NewDetailed(first, time.Minute, WithClock(fc))
+	defer dt.Stop()
+	clone := dt.Clone()
+	defer clone.Stop()
+
+	fc.expectTimer(t, first)
+	fc.Set(first)
+	if tick := receive(t, dt.C); tick.Seq != 1 {
+		t.Errorf("expected tick 1, but got %+v", tick)
+	}
+	if tick := receive(t, clone.C); tick.Seq != 1 {
+		t.Errorf("expected tick 1 of the clone, but got %+v", tick)
+	}
+}
+
+func TestCloneManual(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	st := NewManual(first, time.Minute)
+	defer st.Stop()
+	st.Tick(first)
+	receive(t, st.C)
+
+	clone := st.Clone()
+	defer clone.Stop()
+	if fired, _ := clone.Tick(first.Add(30 * time.Second)); fired {
+		t.Error("expected no tick before the next one of the schedule")
+	}
+	if fired, tick := clone.Tick(first.Add(time.Minute)); !fired || !tick.Equal(first.Add(time.Minute)) {
+		t.Errorf("expected tick at %v, but got %v, %v", first.Add(time.Minute), fired, tick)
+	}
+}
+
+func TestCloneFunc(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(first.Add(-time.Second))
+	calls := make(chan time.Time, 2)
+	st := NewFunc(first, time.Minute, func(t time.Time) { calls <- t }, WithClock(fc))
+	clone := st.Clone()
+	defer clone.Stop()
+	if clone.C != nil {
+		t.Error("expected nil C of the clone")
+	}
+	st.Stop()
+
+	fc.expectTimer(t, first)
+	fc.Set(first)
+	if call := receive(t, calls); !call.Equal(first) {
+		t.Errorf("expected call of the shared function for tick at %v, but got %v", first, call)
+	}
+	expectNothing(t, calls)
+}
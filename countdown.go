@@ -0,0 +1,50 @@
+package sticker
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// FormatCountdown returns a human readable description of the time until the next tick like "in 2m 13s".
+// A tick that is due is described as "now". If no tick is scheduled FormatCountdown returns an empty string.
+// The format can be changed by [WithCountdownFormatter].
+func (st *ScheduledTicker) FormatCountdown() string {
+	if st == nil {
+		return ""
+	}
+	if st.NextTick().IsZero() {
+		return ""
+	}
+	return st.countdown(st.Until())
+}
+
+// WithCountdownFormatter replaces how [ScheduledTicker.FormatCountdown] describes the duration until the next tick,
+// e.g. to localize it. f is called with the time until the next tick which is not positive if the tick is due.
+func WithCountdownFormatter(f func(time.Duration) string) Option {
+	return func(st *ScheduledTicker) {
+		st.countdown = f
+	}
+}
+
+// formatCountdown is the default formatter of FormatCountdown.
+// Partial seconds are rounded up so that a tick is only described as "now" once it is due.
+func formatCountdown(d time.Duration) string {
+	if d <= 0 {
+		return "now"
+	}
+	s := int64((d + time.Second - 1) / time.Second)
+	h, m := s/3600, s/60%60
+	s %= 60
+
+	var b strings.Builder
+	b.WriteString("in ")
+	if h > 0 {
+		fmt.Fprintf(&b, "%dh ", h)
+	}
+	if h > 0 || m > 0 {
+		fmt.Fprintf(&b, "%dm ", m)
+	}
+	fmt.Fprintf(&b, "%ds", s)
+	return b.String()
+}
@@ -0,0 +1,58 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatCountdown(t *testing.T) {
+	cases := []struct {
+		name     string
+		until    time.Duration
+		expected string
+	}{
+		{name: "past", until: -time.Second, expected: "now"},
+		{name: "due", until: 0, expected: "now"},
+		{name: "subSecond", until: 300 * time.Millisecond, expected: "in 1s"},
+		{name: "seconds", until: 42 * time.Second, expected: "in 42s"},
+		{name: "minutes", until: 2*time.Minute + 13*time.Second, expected: "in 2m 13s"},
+		{name: "hours", until: 3*time.Hour + 5*time.Second, expected: "in 3h 0m 5s"},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if s := formatCountdown(tc.until); s != tc.expected {
+				t.Errorf("expected %q, but got %q", tc.expected, s)
+			}
+		})
+	}
+}
+
+func TestTickerFormatCountdown(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(now)
+	st := New(now.Add(2*time.Minute+13*time.Second), time.Hour, WithClock(fc))
+	defer st.Stop()
+
+	if s, want := st.FormatCountdown(), "in 2m 13s"; s != want {
+		t.Errorf("expected %q, but got %q", want, s)
+	}
+	fc.Advance(2*time.Minute + 12*time.Second + 700*time.Millisecond)
+	if s, want := st.FormatCountdown(), "in 1s"; s != want {
+		t.Errorf("expected %q, but got %q", want, s)
+	}
+}
+
+func TestCountdownFormatter(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(now)
+	st := New(now.Add(90*time.Second), time.Hour, WithClock(fc), WithCountdownFormatter(func(d time.Duration) string {
+		return d.String()
+	}))
+	defer st.Stop()
+
+	if s, want := st.FormatCountdown(), "1m30s"; s != want {
+		t.Errorf("expected %q, but got %q", want, s)
+	}
+}
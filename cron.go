@@ -0,0 +1,206 @@
+package sticker
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NewCron returns a new ScheduledTicker that ticks according to the cron expression spec in the local time zone.
+// spec has the five fields minute, hour, day of month, month and day of week separated by spaces, e.g.
+// "*/15 9-17 * * 1-5" for every quarter of an hour during office hours on weekdays. Each field is
+// either "*" or a comma-separated list of numbers and ranges like "1-5", each optionally followed by
+// a step like "/2". Months and days of week may also be given by their English three-letter names
+// like "JAN" or "MON-FRI" in any case. Day of week 0 and 7 are Sunday. If both day of month and day of week are restricted,
+// a day matching either of them is scheduled. An error is returned if spec is not a valid expression
+// or never matches, like "0 0 31 2 *" for February 31. Stop the ticker to release associated resources.
+func NewCron(spec string, opts ...Option) (*ScheduledTicker, error) {
+	c, err := parseCron(spec, time.Local)
+	if err != nil {
+		return nil, err
+	}
+	ticker := newChanTicker(context.Background(), opts)
+	now := ticker.clock.Now()
+	next := c.next(now)
+	if next.IsZero() {
+		ticker.Stop()
+		return nil, fmt.Errorf("invalid cron spec %q: no point in time after %v", spec, now)
+	}
+	ticker.startSchedule(c, c.next(next).Sub(next))
+	return ticker, nil
+}
+
+// Cron parses the cron expression spec like NewCron and returns it as a Schedule in the local time zone,
+// e.g. to pass it to NewFromSchedule or to compute upcoming points in time without a ticker.
+// An error is returned if spec is not a valid expression.
+func Cron(spec string) (Schedule, error) {
+	c, err := parseCron(spec, time.Local)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// MustCron is like NewCron but panics if spec is not a valid cron expression.
+// It simplifies the initialization of global variables holding tickers with a static schedule.
+func MustCron(spec string, opts ...Option) *ScheduledTicker {
+	ticker, err := NewCron(spec, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return ticker
+}
+
+// cronSearchLimit is how far into the future a cron schedule is searched for the next point in time.
+// It is long enough to find the next February 29.
+const cronSearchLimit = 5
+
+// cron is the schedule of a cron expression.
+type cron struct {
+	minute, hour, dom, month, dow bits
+	domStar, dowStar              bool // Whether day of month or day of week are unrestricted.
+	loc                           *time.Location
+}
+
+// bits is a set of small non-negative numbers.
+type bits uint64
+
+func (b bits) has(i int) bool {
+	return b&(1<<uint(i)) != 0
+}
+
+// cronField describes the range of values of a field of a cron expression.
+type cronField struct {
+	name     string
+	min, max int
+	names    []string // Names of the values starting at min, if any.
+}
+
+var cronFields = [...]cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{name: "day of week", min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+}
+
+func parseCron(spec string, loc *time.Location) (*cron, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != len(cronFields) {
+		return nil, fmt.Errorf("invalid cron spec %q: expected %d fields, but got %d", spec, len(cronFields), len(fields))
+	}
+	var sets [len(cronFields)]bits
+	for i, field := range fields {
+		set, err := cronFields[i].parse(field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron spec %q: %w", spec, err)
+		}
+		sets[i] = set
+	}
+	c := &cron{
+		minute:  sets[0],
+		hour:    sets[1],
+		dom:     sets[2],
+		month:   sets[3],
+		dow:     sets[4],
+		domStar: strings.HasPrefix(fields[2], "*"),
+		dowStar: strings.HasPrefix(fields[4], "*"),
+		loc:     loc,
+	}
+	// Sunday is both 0 and 7.
+	if c.dow.has(7) {
+		c.dow |= 1
+	}
+	return c, nil
+}
+
+// parse parses the comma-separated list of ranges s.
+func (f cronField) parse(s string) (bits, error) {
+	var set bits
+	for _, part := range strings.Split(s, ",") {
+		rng, step, hasStep := strings.Cut(part, "/")
+		lo, hi := f.min, f.max
+		if rng != "*" {
+			from, to, isRange := strings.Cut(rng, "-")
+			var err error
+			if lo, err = f.value(from); err != nil {
+				return 0, err
+			}
+			if isRange {
+				if hi, err = f.value(to); err != nil {
+					return 0, err
+				}
+				if hi < lo {
+					return 0, fmt.Errorf("invalid range %q of %s", rng, f.name)
+				}
+			} else if !hasStep {
+				hi = lo
+			}
+		}
+		n := 1
+		if hasStep {
+			var err error
+			if n, err = strconv.Atoi(step); err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step %q of %s", step, f.name)
+			}
+		}
+		for i := lo; i <= hi; i += n {
+			set |= 1 << uint(i)
+		}
+	}
+	return set, nil
+}
+
+// value parses a single value of the field.
+func (f cronField) value(s string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(s, name) {
+			return f.min + i, nil
+		}
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", f.name, s)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("%s %d out of range [%d, %d]", f.name, v, f.min, f.max)
+	}
+	return v, nil
+}
+
+// Next implements Schedule.
+func (c *cron) Next(after time.Time) time.Time {
+	return c.next(after)
+}
+
+func (c *cron) next(t time.Time) time.Time {
+	// NOTE: truncate the absolute time since the wall clock time is ambiguous when clocks are turned back.
+	t = t.In(c.loc).Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(cronSearchLimit, 0, 0)
+	for t.Before(limit) {
+		switch {
+		case !c.month.has(int(t.Month())):
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, c.loc)
+		case !c.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, c.loc)
+		case !c.hour.has(t.Hour()):
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, c.loc)
+		case !c.minute.has(t.Minute()):
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// dayMatches reports whether the day of t is scheduled.
+func (c *cron) dayMatches(t time.Time) bool {
+	dom, dow := c.dom.has(t.Day()), c.dow.has(int(t.Weekday()))
+	if c.domStar || c.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
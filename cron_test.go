@@ -0,0 +1,180 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCronNext(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 34, 56, 0, time.UTC) // A Thursday.
+	cases := []struct {
+		spec string
+		want []time.Time
+	}{
+		{
+			spec: "* * * * *",
+			want: []time.Time{
+				time.Date(2023, 6, 1, 12, 35, 0, 0, time.UTC),
+				time.Date(2023, 6, 1, 12, 36, 0, 0, time.UTC),
+			},
+		},
+		{
+			spec: "*/15 9-17 * * 1-5",
+			want: []time.Time{
+				time.Date(2023, 6, 1, 12, 45, 0, 0, time.UTC),
+				time.Date(2023, 6, 1, 13, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			spec: "0 9 * * 0,6",
+			want: []time.Time{
+				time.Date(2023, 6, 3, 9, 0, 0, 0, time.UTC),
+				time.Date(2023, 6, 4, 9, 0, 0, 0, time.UTC),
+				time.Date(2023, 6, 10, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			spec: "30 2 29 2 *",
+			want: []time.Time{
+				time.Date(2024, 2, 29, 2, 30, 0, 0, time.UTC),
+				time.Date(2028, 2, 29, 2, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			// Either the first of a month or a Sunday.
+			spec: "0 0 1 * 7",
+			want: []time.Time{
+				time.Date(2023, 6, 4, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 6, 11, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 6, 18, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 6, 25, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			spec: "5/20 */6 1-2 1/6 *",
+			want: []time.Time{
+				time.Date(2023, 7, 1, 0, 5, 0, 0, time.UTC),
+				time.Date(2023, 7, 1, 0, 25, 0, 0, time.UTC),
+				time.Date(2023, 7, 1, 0, 45, 0, 0, time.UTC),
+				time.Date(2023, 7, 1, 6, 5, 0, 0, time.UTC),
+			},
+		},
+		{
+			spec: "*/5 8-18 * * MON-FRI",
+			want: []time.Time{
+				time.Date(2023, 6, 1, 12, 35, 0, 0, time.UTC),
+				time.Date(2023, 6, 1, 12, 40, 0, 0, time.UTC),
+			},
+		},
+		{
+			spec: "0 12 1 jan,Jul *",
+			want: []time.Time{
+				time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			spec: "0 0 30 2 *",
+			want: []time.Time{{}},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.spec, func(t *testing.T) {
+			c, err := parseCron(tc.spec, time.UTC)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			next := now
+			for _, want := range tc.want {
+				next = c.next(next)
+				if !next.Equal(want) {
+					t.Fatalf("expected %v, but got %v", want, next)
+				}
+			}
+		})
+	}
+}
+
+func TestCronDaylightSavingTime(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+	c, err := parseCron("30 * * * *", berlin)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Clocks are turned back from 03:00 CEST to 02:00 CET on 2023-10-29, so 02:30 happens twice.
+	next := time.Date(2023, 10, 29, 1, 0, 0, 0, berlin)
+	for _, want := range []string{"01:30 CEST", "02:30 CEST", "02:30 CET", "03:30 CET"} {
+		next = c.next(next)
+		if got := next.Format("15:04 MST"); got != want {
+			t.Errorf("expected %s, but got %s", want, got)
+		}
+	}
+}
+
+func TestNewCronInvalid(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"1,,2 * * * *",
+		"* * * FOO *",
+		"* * * * MON-SUN",
+		"0 0 31 2 *",
+		"0 0 31 4,6,9,11 *",
+	} {
+		if _, err := NewCron(spec); err == nil {
+			t.Errorf("expected error for %q", spec)
+		}
+	}
+}
+
+func TestCron(t *testing.T) {
+	s, err := Cron("30 * * * MON-FRI")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	now := time.Date(2023, 6, 2, 23, 34, 56, 0, time.Local) // A Friday.
+	if next, want := s.Next(now), time.Date(2023, 6, 5, 0, 30, 0, 0, time.Local); !next.Equal(want) {
+		t.Errorf("expected %v, but got %v", want, next)
+	}
+	st := NewFromSchedule(s, WithClock(newFakeClock(now)))
+	defer st.Stop()
+	if next, want := st.NextTick(), time.Date(2023, 6, 5, 0, 30, 0, 0, time.Local); !next.Equal(want) {
+		t.Errorf("expected first tick at %v, but got %v", want, next)
+	}
+
+	if _, err := Cron("* * * *"); err == nil {
+		t.Error("expected error for invalid spec")
+	}
+}
+
+func TestMustCron(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 34, 56, 0, time.Local)
+	st := MustCron("0 * * * *", WithClock(newFakeClock(now)))
+	defer st.Stop()
+	if next, want := st.NextTick(), time.Date(2023, 6, 1, 13, 0, 0, 0, time.Local); !next.Equal(want) {
+		t.Errorf("expected first tick at %v, but got %v", want, next)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for invalid spec")
+		}
+	}()
+	MustCron("invalid")
+}
@@ -0,0 +1,57 @@
+package sticker
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// NewDailyWindow returns a new ScheduledTicker that ticks at interval during a window of every day
+// in loc from the time of day start up to but excluding the time of day end, e.g. every 5 minutes
+// from 09:00 to 17:00. The first tick of each window is at start. After end the ticker rolls over to
+// start of the next day. If end is before start the window spans midnight.
+// The durations start and end must be within [0, 24h) and differ, interval must be greater than zero
+// and loc must not be nil; if not, NewDailyWindow will panic.
+// Stop the ticker to release associated resources.
+func NewDailyWindow(start, end, interval time.Duration, loc *time.Location, opts ...Option) *ScheduledTicker {
+	if start < 0 || start >= day || end < 0 || end >= day || start == end {
+		panic(errors.New("invalid window for NewDailyWindow ScheduledTicker"))
+	}
+	if interval <= 0 {
+		panic(errors.New("non-positive interval for NewDailyWindow ScheduledTicker"))
+	}
+	if loc == nil {
+		panic(errors.New("nil location for NewDailyWindow ScheduledTicker"))
+	}
+	ticker := newChanTicker(context.Background(), opts)
+	ticker.startSchedule(dailyWindow{start: daily{at: start, loc: loc}, end: daily{at: end, loc: loc}, interval: interval}, interval)
+	return ticker
+}
+
+// dailyWindow is the schedule of ticks at interval during a window of every day.
+type dailyWindow struct {
+	start, end daily
+	interval   time.Duration
+}
+
+func (w dailyWindow) next(t time.Time) time.Time {
+	// The window of the day before might still be open if it spans midnight.
+	for days := -1; ; days++ {
+		ws, we := w.window(t, days)
+		if t.Before(ws) {
+			return ws
+		}
+		if n := NextRun(ws, w.interval, t); n.Before(we) {
+			return n
+		}
+	}
+}
+
+// window returns the start and end of the window on the day that is days after the day of t.
+func (w dailyWindow) window(t time.Time, days int) (start, end time.Time) {
+	start, end = w.start.on(t, days), w.end.on(t, days)
+	if !end.After(start) {
+		end = w.end.on(t, days+1)
+	}
+	return start, end
+}
@@ -0,0 +1,74 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDailyWindow(t *testing.T) {
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	at := func(day, hour, min int) time.Time {
+		return time.Date(2023, 3, day, hour, min, 0, 0, loc)
+	}
+	fc := newFakeClock(at(1, 16, 30))
+	st := NewDailyWindow(9*time.Hour, 17*time.Hour, 20*time.Minute, loc, WithClock(fc))
+	defer st.Stop()
+
+	for _, want := range []time.Time{
+		// The last tick of the window right before its end.
+		at(1, 16, 40),
+		// No ticks overnight until the window opens again.
+		at(2, 9, 0),
+		at(2, 9, 20),
+	} {
+		fc.expectTimer(t, want)
+		fc.Set(want)
+		if tick := receive(t, st.C); !tick.Equal(want) {
+			t.Errorf("expected tick at %v, but got %v", want, tick)
+		}
+	}
+}
+
+func TestDailyWindowNext(t *testing.T) {
+	at := func(day, hour, min int) time.Time {
+		return time.Date(2023, 3, day, hour, min, 0, 0, time.UTC)
+	}
+	for _, tc := range []struct {
+		name       string
+		start, end time.Duration
+		t, want    time.Time
+	}{
+		{"beforeWindow", 9 * time.Hour, 17 * time.Hour, at(1, 8, 0), at(1, 9, 0)},
+		{"inWindow", 9 * time.Hour, 17 * time.Hour, at(1, 9, 0), at(1, 9, 25)},
+		{"endExcluded", 9 * time.Hour, 17 * time.Hour, at(1, 16, 55), at(2, 9, 0)},
+		{"afterWindow", 9 * time.Hour, 17 * time.Hour, at(1, 18, 0), at(2, 9, 0)},
+		{"overnightBeforeMidnight", 22 * time.Hour, 2 * time.Hour, at(1, 23, 55), at(2, 0, 5)},
+		{"overnightAfterMidnight", 22 * time.Hour, 2 * time.Hour, at(2, 1, 50), at(2, 22, 0)},
+		{"overnightEarlyMorning", 22 * time.Hour, 2 * time.Hour, at(2, 0, 20), at(2, 0, 30)},
+	} {
+		w := dailyWindow{
+			start:    daily{at: tc.start, loc: time.UTC},
+			end:      daily{at: tc.end, loc: time.UTC},
+			interval: 25 * time.Minute,
+		}
+		if next := w.next(tc.t); !next.Equal(tc.want) {
+			t.Errorf("%s: expected next tick after %v at %v, but got %v", tc.name, tc.t, tc.want, next)
+		}
+	}
+}
+
+func TestDailyWindowBounds(t *testing.T) {
+	// The bounds are late in the day and have fractions of a second, so their nanoseconds would not fit
+	// into the int of a 32-bit platform.
+	start := 23*time.Hour + 59*time.Minute + 59*time.Second + 500*time.Millisecond
+	end := 250 * time.Millisecond
+	w := dailyWindow{start: daily{at: start, loc: time.UTC}, end: daily{at: end, loc: time.UTC}, interval: 100 * time.Millisecond}
+
+	ws, we := w.window(time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC), 0)
+	if want := time.Date(2023, 6, 1, 23, 59, 59, 500_000_000, time.UTC); !ws.Equal(want) {
+		t.Errorf("expected window to start at %v, but got %v", want, ws)
+	}
+	if want := time.Date(2023, 6, 2, 0, 0, 0, 250_000_000, time.UTC); !we.Equal(want) {
+		t.Errorf("expected window to end at %v, but got %v", want, we)
+	}
+}
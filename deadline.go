@@ -0,0 +1,36 @@
+package sticker
+
+import (
+	"context"
+	"time"
+)
+
+// NewDeadline returns a new DetailedTicker that ticks once at the deadline at and then stops itself,
+// e.g. for a job that must run by 17:00, ideally at 17:00. If at has already passed when the ticker is
+// created the tick fires immediately instead and is marked as [Tick.Late], with [Tick.Drift] telling
+// by how much the deadline was missed.
+// Stop the ticker to release associated resources.
+func NewDeadline(at time.Time, opts ...Option) *DetailedTicker {
+	ticker := newDetailed(context.Background(), opts)
+	ticker.mu.Lock()
+	ticker.setCustomSchedule(ticker.clock.Now(), once{at: at}, 0)
+	if ticker.next.IsZero() && !ticker.isPaused() {
+		// The deadline has passed already, so fire right away.
+		ticker.setNext(at)
+	}
+	ticker.mu.Unlock()
+	ticker.launch()
+	return ticker
+}
+
+// once is the schedule of a single tick at the point in time at.
+type once struct {
+	at time.Time
+}
+
+func (o once) next(t time.Time) time.Time {
+	if t.Before(o.at) {
+		return o.at
+	}
+	return time.Time{}
+}
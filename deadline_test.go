@@ -0,0 +1,42 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDeadline(t *testing.T) {
+	at := time.Date(2023, 1, 1, 17, 0, 0, 0, time.UTC)
+
+	t.Run("onTime", func(t *testing.T) {
+		fc := newFakeClock(at.Add(-time.Hour))
+		dt := NewDeadline(at, WithClock(fc))
+		defer dt.Stop()
+
+		fc.expectTimer(t, at)
+		fc.Set(at)
+		if tick := receive(t, dt.C); !tick.Scheduled.Equal(at) || tick.Late || tick.Drift() != 0 {
+			t.Errorf("expected tick on time at %v, but got %+v", at, tick)
+		}
+		for !dt.Stopped() {
+			time.Sleep(time.Millisecond)
+		}
+	})
+
+	t.Run("missed", func(t *testing.T) {
+		fc := newFakeClock(at.Add(10 * time.Minute))
+		dt := NewDeadline(at, WithClock(fc))
+		defer dt.Stop()
+
+		tick := receive(t, dt.C)
+		if !tick.Scheduled.Equal(at) || !tick.Late {
+			t.Errorf("expected late tick for %v, but got %+v", at, tick)
+		}
+		if drift := tick.Drift(); drift != 10*time.Minute {
+			t.Errorf("expected deadline missed by %v, but got %v", 10*time.Minute, drift)
+		}
+		for !dt.Stopped() {
+			time.Sleep(time.Millisecond)
+		}
+	})
+}
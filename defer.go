@@ -0,0 +1,48 @@
+package sticker
+
+import (
+	"errors"
+	"time"
+)
+
+// Defer delays only the next tick by extra, e.g. to skip over a short maintenance of a downstream service.
+// The schedule is not changed: the deferred tick still reports the point in time it was scheduled for in
+// [Tick.Scheduled], and the following ticks arrive at their regular points in time. If the deferred tick
+// is delayed past later points in time of the schedule, the ticks of these are skipped.
+// Defer does nothing if no tick is scheduled, e.g. while the ticker is paused.
+// The duration extra must not be negative; if it is, Defer will panic.
+func (st *ScheduledTicker) Defer(extra time.Duration) {
+	if st == nil {
+		return
+	}
+	if extra < 0 {
+		panic(errors.New("negative delay for ScheduledTicker.Defer"))
+	}
+	st.mu.Lock()
+	if st.next.IsZero() {
+		st.mu.Unlock()
+		return
+	}
+	st.fireAt = st.fireAt.Add(extra)
+	st.mu.Unlock()
+	st.notify()
+}
+
+// ForceNextTickAt makes the next tick fire at t instead of at the next point in time of the schedule,
+// e.g. to reproduce a specific timing in a test. The tick reports t in [Tick.Scheduled]. The schedule is
+// not changed, so the following ticks arrive at the points in time of the schedule after t. A t in the
+// past fires immediately. ForceNextTickAt does nothing if t is the zero time or no tick is scheduled,
+// e.g. while the ticker is paused.
+func (st *ScheduledTicker) ForceNextTickAt(t time.Time) {
+	if st == nil {
+		return
+	}
+	st.mu.Lock()
+	if st.next.IsZero() || t.IsZero() {
+		st.mu.Unlock()
+		return
+	}
+	st.next, st.fireAt = t, t
+	st.mu.Unlock()
+	st.notify()
+}
@@ -0,0 +1,68 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDefer(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	dt := NewDetailed(first, interval, WithClock(fc))
+	defer dt.Stop()
+
+	fc.expectTimer(t, first)
+	fc.Set(first)
+	receive(t, dt.C)
+
+	// Only the next tick is delayed.
+	fc.expectTimer(t, first.Add(interval))
+	dt.Defer(20 * time.Second)
+	deferred := first.Add(interval + 20*time.Second)
+	if next := dt.NextTick(); !next.Equal(deferred) {
+		t.Errorf("expected next tick at %v, but got %v", deferred, next)
+	}
+	fc.expectTimer(t, deferred)
+	fc.Set(first.Add(interval))
+	expectNothing(t, dt.C)
+	fc.Set(deferred)
+	if tick := receive(t, dt.C); !tick.Actual.Equal(deferred) || !tick.Scheduled.Equal(first.Add(interval)) {
+		t.Errorf("expected tick for %v at %v, but got %+v", first.Add(interval), deferred, tick)
+	}
+
+	// The following ticks are at their regular points in time.
+	for i := 2; i < 4; i++ {
+		next := first.Add(time.Duration(i) * interval)
+		fc.expectTimer(t, next)
+		fc.Set(next)
+		if tick := receive(t, dt.C); !tick.Actual.Equal(next) {
+			t.Errorf("expected tick at %v, but got %+v", next, tick)
+		}
+	}
+}
+
+func TestForceNextTickAt(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	dt := NewDetailed(first, interval, WithClock(fc))
+	defer dt.Stop()
+
+	fc.expectTimer(t, first)
+	forced := first.Add(90 * time.Second)
+	dt.ForceNextTickAt(forced)
+	fc.expectTimer(t, forced)
+	fc.Set(forced)
+	if tick := receive(t, dt.C); !tick.Scheduled.Equal(forced) {
+		t.Errorf("expected tick at %v, but got %+v", forced, tick)
+	}
+
+	// The following tick is back on the schedule.
+	next := first.Add(2 * interval)
+	fc.expectTimer(t, next)
+	fc.Set(next)
+	if tick := receive(t, dt.C); !tick.Scheduled.Equal(next) {
+		t.Errorf("expected tick at %v, but got %+v", next, tick)
+	}
+}
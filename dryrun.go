@@ -0,0 +1,27 @@
+package sticker
+
+import "time"
+
+// WithDryRun starts the ticker in dry-run mode: it follows its schedule but instead of delivering a tick,
+// on C or to subscribers, it calls log with the point in time the tick was scheduled for. This allows to
+// confirm the timing of a new schedule in production before enabling real work. Dry-run ticks are not
+// counted in Stats and do not advance [Tick.Seq]. Heartbeats and ticks of Fire are delivered as usual.
+// log is called from the goroutine of the ticker and should return quickly; it may be nil.
+// Use SetDryRun to switch between dry-run and delivery.
+func WithDryRun(log func(scheduled time.Time)) Option {
+	return func(st *ScheduledTicker) {
+		st.dryRun = true
+		st.dryRunLog = log
+	}
+}
+
+// SetDryRun switches the ticker to dry-run mode as described by WithDryRun, or back to delivering its ticks.
+// Ticks are logged with the function given to WithDryRun, if any. The change applies from the next tick on.
+func (st *ScheduledTicker) SetDryRun(on bool) {
+	if st == nil {
+		return
+	}
+	st.mu.Lock()
+	st.dryRun = on
+	st.mu.Unlock()
+}
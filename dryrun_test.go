@@ -0,0 +1,42 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDryRun(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	logged := make(chan time.Time, 3)
+	dt := NewDetailed(first, interval, WithDryRun(func(scheduled time.Time) {
+		logged <- scheduled
+	}), WithClock(fc))
+	defer dt.Stop()
+	sub := dt.Subscribe()
+
+	for i := 0; i < 3; i++ {
+		next := first.Add(time.Duration(i) * interval)
+		fc.expectTimer(t, next)
+		fc.Set(next)
+		if scheduled := receive(t, logged); !scheduled.Equal(next) {
+			t.Errorf("expected dry-run tick at %v, but got %v", next, scheduled)
+		}
+		expectNothing(t, dt.C)
+		expectNothing(t, sub)
+	}
+	if s := dt.Stats(); s != (Stats{}) {
+		t.Errorf("expected no ticks counted, but got %+v", s)
+	}
+
+	dt.SetDryRun(false)
+	next := first.Add(3 * interval)
+	fc.expectTimer(t, next)
+	fc.Set(next)
+	if tick := receive(t, dt.C); !tick.Scheduled.Equal(next) || tick.Seq != 1 {
+		t.Errorf("expected first tick at %v, but got %+v", next, tick)
+	}
+	receive(t, sub)
+	expectNothing(t, logged)
+}
@@ -1,6 +1,8 @@
 package sticker_test
 
 import (
+	"context"
+	"fmt"
 	"time"
 
 	"github.com/wilriker/sticker"
@@ -29,3 +31,36 @@ func Example() {
 		}
 	}
 }
+
+// This example demonstrates a ticker that stops on its own once its context is cancelled,
+// without a goroutine that calls Stop.
+func ExampleNewWithContext() {
+	ctx, cancel := context.WithCancel(context.Background())
+	ticker := sticker.NewWithContext(ctx, time.Now(), 10*time.Millisecond)
+
+	<-ticker.C
+	fmt.Println("ticked")
+
+	cancel()
+	<-ticker.Done()
+	fmt.Println("stopped")
+	// Output:
+	// ticked
+	// stopped
+}
+
+// This example demonstrates how to report when the next tick of a ticker fires, e.g. in a health endpoint.
+func ExampleScheduledTicker_NextTick() {
+	first := time.Date(2100, 1, 1, 9, 0, 0, 0, time.UTC)
+	ticker := sticker.New(first, 24*time.Hour)
+
+	fmt.Println("next run at", ticker.NextTick().Format(time.RFC3339))
+	fmt.Println("due in the future:", ticker.Until() > 0)
+
+	ticker.Stop()
+	fmt.Println("scheduled after stop:", !ticker.NextTick().IsZero())
+	// Output:
+	// next run at 2100-01-01T09:00:00Z
+	// due in the future: true
+	// scheduled after stop: false
+}
@@ -0,0 +1,7 @@
+package sticker
+
+// LiveTimers returns the number of timers of the loop of st that are not stopped yet.
+// It is only available to tests so that they can detect leaked timers.
+func (st *ScheduledTicker) LiveTimers() int {
+	return int(st.timers.Load())
+}
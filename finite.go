@@ -0,0 +1,50 @@
+package sticker
+
+import "time"
+
+// WithMaxTicks makes the ticker stop itself after it fired n ticks of its schedule. Ticks of Fire are not counted.
+// A Reset that re-arms the stopped ticker grants it another n ticks. A value of zero, the default, does not
+// limit the number of ticks.
+func WithMaxTicks(n uint64) Option {
+	return func(st *ScheduledTicker) {
+		st.maxTicks = n
+	}
+}
+
+// WithUntil makes the ticker stop itself once its schedule has no more ticks at or before end.
+// The zero time, the default, does not end the schedule.
+func WithUntil(end time.Time) Option {
+	return func(st *ScheduledTicker) {
+		st.until = end
+	}
+}
+
+// pastUntil reports whether the tick scheduled at t is after the end of the schedule set by WithUntil.
+func (st *ScheduledTicker) pastUntil(t time.Time) bool {
+	return !st.until.IsZero() && t.After(st.until)
+}
+
+// Done returns a channel that is closed once the ticker is stopped, either by Stop, because the context
+// it was created with is done or because its schedule is exhausted, e.g. by WithMaxTicks or WithUntil,
+// and its goroutine fully exited, including the time it lingers for WithMinLifetime. So once Done is
+// closed the ticker no longer uses any resources. A Reset that re-arms the stopped ticker starts a new run
+// with a new channel, while the channels returned before stay closed, so call Done again after such a Reset.
+// A nil ticker returns a closed channel.
+func (st *ScheduledTicker) Done() <-chan struct{} {
+	if st == nil {
+		return closedChan
+	}
+	s := st.session.Load()
+	if s.exited != nil {
+		return s.exited
+	}
+	// The ticker has no goroutine of its own.
+	return s.ctx.Done()
+}
+
+// closedChan is the channel returned by Done of a nil ticker.
+var closedChan = func() chan struct{} {
+	c := make(chan struct{})
+	close(c)
+	return c
+}()
@@ -0,0 +1,99 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFiniteSchedule(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+
+	for name, opt := range map[string]Option{
+		"max ticks": WithMaxTicks(2),
+		"until":     WithUntil(first.Add(interval + 30*time.Second)),
+	} {
+		opt := opt
+		t.Run(name, func(t *testing.T) {
+			fc := newFakeClock(first.Add(-time.Second))
+			st := New(first, interval, opt, WithClock(fc))
+			defer st.Stop()
+
+			for _, next := range []time.Time{first, first.Add(interval)} {
+				fc.expectTimer(t, next)
+				fc.Set(next)
+				if tick := receive(t, st.C); !tick.Equal(next) {
+					t.Errorf("expected tick at %v, but got %v", next, tick)
+				}
+			}
+			select {
+			case <-st.Done():
+			case <-time.After(time.Second):
+				t.Fatal("expected Done to be closed after the last tick")
+			}
+			if !st.Stopped() {
+				t.Error("expected ticker to be stopped")
+			}
+		})
+	}
+}
+
+func TestUntilBeforeFirst(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(first.Add(-time.Second))
+	st := New(first, time.Minute, WithUntil(first.Add(-time.Millisecond)), WithClock(fc))
+	defer st.Stop()
+
+	fc.expectTimer(t, first)
+	fc.Set(first)
+	select {
+	case <-st.Done():
+	case <-time.After(time.Second):
+		t.Fatal("expected Done to be closed instead of a tick after the end")
+	}
+	expectNothing(t, st.C)
+}
+
+func TestDone(t *testing.T) {
+	st := New(time.Now().Add(time.Hour), time.Hour)
+	select {
+	case <-st.Done():
+		t.Fatal("expected Done not to be closed while running")
+	default:
+	}
+	st.Stop()
+	<-st.Done()
+
+	var nilTicker *ScheduledTicker
+	<-nilTicker.Done()
+}
+
+func TestMaxTicksReset(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	st := New(first, interval, WithMaxTicks(2), WithClock(fc))
+	defer st.Stop()
+
+	// The limit applies to each run of the ticker anew.
+	for run := 0; run < 2; run++ {
+		start := first.Add(time.Duration(10*run) * interval)
+		if run > 0 {
+			fc.Set(start.Add(-time.Second))
+			st.Reset(start, interval)
+		}
+		for i := 0; i < 2; i++ {
+			next := start.Add(time.Duration(i) * interval)
+			fc.expectTimer(t, next)
+			fc.Set(next)
+			if tick := receive(t, st.C); !tick.Equal(next) {
+				t.Errorf("expected tick at %v, but got %v", next, tick)
+			}
+		}
+		select {
+		case <-st.Done():
+		case <-time.After(time.Second):
+			t.Fatalf("expected Done to be closed after the last tick of run %d", run)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package sticker
+
+// Fire delivers a tick immediately in addition to the ticks of the schedule, e.g. to refresh on user request.
+// The schedule is not affected, so the next tick still arrives at its regular point in time.
+// Like any other tick it is dropped if C is full. The tick is delivered to subscribers of
+// every tick but is not counted by EveryNth. On a DetailedTicker it is marked as [Tick.Manual].
+// Fire does nothing if the ticker is stopped.
+func (st *ScheduledTicker) Fire() {
+	if st == nil {
+		return
+	}
+	if st.ctx().Err() != nil {
+		return
+	}
+	now := st.clock.Now()
+	if st.loc != nil {
+		now = now.In(st.loc)
+	}
+	st.mu.Lock()
+	t := Tick{
+		Scheduled: now,
+		Actual:    now,
+		Dropped:   st.dropped,
+		Manual:    true,
+	}
+	subs := st.subs
+	st.mu.Unlock()
+	st.fire(t, subs, false)
+}
@@ -0,0 +1,45 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFire(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	dt := NewDetailed(first, interval, WithClock(fc))
+	defer dt.Stop()
+	sub := dt.Subscribe()
+	nth := dt.EveryNth(2)
+
+	fc.expectTimer(t, first)
+	fc.Set(first)
+	if tick := receive(t, dt.C); tick.Manual || tick.Seq != 1 {
+		t.Errorf("expected first scheduled tick, but got %+v", tick)
+	}
+	receive(t, sub)
+
+	manual := first.Add(20 * time.Second)
+	fc.Set(manual)
+	dt.Fire()
+	tick := receive(t, dt.C)
+	if !tick.Manual || !tick.Actual.Equal(manual) {
+		t.Errorf("expected manual tick at %v, but got %+v", manual, tick)
+	}
+	if tick := receive(t, sub); !tick.Equal(manual) {
+		t.Errorf("expected manual tick at %v for subscriber, but got %v", manual, tick)
+	}
+	expectNothing(t, nth)
+
+	// The schedule continues unaffected.
+	fc.expectTimer(t, first.Add(interval))
+	fc.Set(first.Add(interval))
+	if tick := receive(t, dt.C); tick.Manual || tick.Seq != 2 || !tick.Scheduled.Equal(first.Add(interval)) {
+		t.Errorf("expected second scheduled tick at %v, but got %+v", first.Add(interval), tick)
+	}
+	if tick := receive(t, nth); !tick.Equal(first.Add(interval)) {
+		t.Errorf("expected second scheduled tick for every 2nd subscriber, but got %v", tick)
+	}
+}
@@ -0,0 +1,76 @@
+package sticker
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// NewFunc returns a new ScheduledTicker like New that calls f with the time of each tick in its own
+// goroutine instead of delivering the tick on C, which is nil, analogous to time.AfterFunc. Since every
+// call has its own goroutine, a slow f does not delay the following ticks and calls may overlap.
+// A panic of f crashes the program unless the ticker has a panic handler set by WithPanicHandler.
+// The duration interval must be greater than zero and f must not be nil; if not, NewFunc will panic.
+// Stop the ticker to release associated resources. Calls of f that already started are not waited for.
+func NewFunc(first time.Time, interval time.Duration, f func(time.Time), opts ...Option) *ScheduledTicker {
+	if interval <= 0 {
+		panic(errors.New("non-positive interval for NewFunc ScheduledTicker"))
+	}
+	if f == nil {
+		panic(errors.New("nil func for NewFunc ScheduledTicker"))
+	}
+	ticker := newChanTicker(context.Background(), opts)
+	c := ticker.detach(f)
+	ticker.start(first, interval)
+	ticker.callEach(c)
+	return ticker
+}
+
+// detach makes st call f for each tick instead of delivering it on C and returns the channel the ticks
+// are delivered on internally. It must be called before st is started.
+func (st *ScheduledTicker) detach(f func(time.Time)) <-chan time.Time {
+	// NOTE: the goroutine calling f is not restarted.
+	st.final = true
+	st.fn = f
+	c := st.C
+	st.C = nil
+	return c
+}
+
+// callEach calls the function of st with each tick received on c until st is stopped.
+func (st *ScheduledTicker) callEach(c <-chan time.Time) {
+	go func() {
+		for {
+			select {
+			case <-st.ctx().Done():
+				return
+			case t, ok := <-c:
+				if !ok {
+					return
+				}
+				go st.call(st.fn, t)
+			}
+		}
+	}()
+}
+
+// WithPanicHandler makes a ticker created by NewFunc recover from a panic of its function and
+// call h with the recovered value, e.g. to log it. The ticker keeps running.
+// With WithSupervisor h is also called with the value recovered from a panic of the goroutine of the ticker.
+func WithPanicHandler(h func(recovered any)) Option {
+	return func(st *ScheduledTicker) {
+		st.onPanic = h
+	}
+}
+
+// call calls f for the tick at t and passes a panic of f to the panic handler if there is one.
+func (st *ScheduledTicker) call(f func(time.Time), t time.Time) {
+	if st.onPanic != nil {
+		defer func() {
+			if r := recover(); r != nil {
+				st.onPanic(r)
+			}
+		}()
+	}
+	f(t)
+}
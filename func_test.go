@@ -0,0 +1,56 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewFunc(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	calls := make(chan time.Time, 2)
+	recovered := make(chan any, 1)
+	st := NewFunc(first, interval, func(t time.Time) {
+		calls <- t
+		if t.Equal(first) {
+			panic("boom")
+		}
+	}, WithPanicHandler(func(r any) { recovered <- r }), WithClock(fc))
+	defer st.Stop()
+	if st.C != nil {
+		t.Error("expected nil C")
+	}
+
+	fc.expectTimer(t, first)
+	fc.Set(first)
+	if call := receive(t, calls); !call.Equal(first) {
+		t.Errorf("expected call for tick at %v, but got %v", first, call)
+	}
+	if r := receive(t, recovered); r != "boom" {
+		t.Errorf("expected recovered panic boom, but got %v", r)
+	}
+
+	fc.expectTimer(t, first.Add(interval))
+	fc.Set(first.Add(interval))
+	if call := receive(t, calls); !call.Equal(first.Add(interval)) {
+		t.Errorf("expected call for tick at %v after a panic, but got %v", first.Add(interval), call)
+	}
+}
+
+func TestNewFuncInvalid(t *testing.T) {
+	for name, f := range map[string]func(){
+		"interval": func() { NewFunc(time.Now(), 0, func(time.Time) {}) },
+		"func":     func() { NewFunc(time.Now(), time.Minute, nil) },
+	} {
+		f := f
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected panic")
+				}
+			}()
+			f()
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package sticker
+
+import "sync"
+
+// Group manages the lifecycle of related tickers together, e.g. to pause all of them during a
+// maintenance window. The zero value is an empty group ready to use.
+// A Group must not be copied after first use.
+type Group struct {
+	mu      sync.Mutex
+	tickers []*ScheduledTicker
+	paused  bool
+}
+
+// Add registers tickers with the group. While the group is paused they are paused as well.
+// Use the embedded ScheduledTicker to add a DetailedTicker. Nil tickers are ignored.
+func (g *Group) Add(tickers ...*ScheduledTicker) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, st := range tickers {
+		if st == nil {
+			continue
+		}
+		if g.paused {
+			st.Pause()
+		}
+		g.tickers = append(g.tickers, st)
+	}
+}
+
+// Remove unregisters st from the group so it is no longer affected by the group.
+// If it was paused by the group it is resumed.
+func (g *Group) Remove(st *ScheduledTicker) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	tickers := make([]*ScheduledTicker, 0, len(g.tickers))
+	for _, t := range g.tickers {
+		if t != st {
+			tickers = append(tickers, t)
+			continue
+		}
+		if g.paused {
+			t.Resume()
+		}
+	}
+	g.tickers = tickers
+}
+
+// PauseAll pauses all tickers of the group. No tick is delivered until ResumeAll, but their schedules are kept.
+func (g *Group) PauseAll() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.paused = true
+	for _, st := range g.tickers {
+		st.Pause()
+	}
+}
+
+// ResumeAll resumes all tickers of the group paused by PauseAll. They continue with
+// the next tick of their schedule; ticks missed while paused are skipped.
+func (g *Group) ResumeAll() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.paused = false
+	for _, st := range g.tickers {
+		st.Resume()
+	}
+}
+
+// StopAll stops all tickers of the group and removes them from it.
+func (g *Group) StopAll() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, st := range g.tickers {
+		st.Stop()
+	}
+	g.tickers = nil
+}
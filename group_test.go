@@ -0,0 +1,58 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGroup(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	var clocks []*fakeClock
+	var g Group
+	for i := 0; i < 3; i++ {
+		fc := newFakeClock(first.Add(-time.Second))
+		clocks = append(clocks, fc)
+		g.Add(New(first, interval, WithClock(fc)))
+	}
+	removed := g.tickers[2]
+	g.Remove(removed)
+	defer removed.Stop()
+
+	for _, fc := range clocks {
+		fc.expectTimer(t, first)
+	}
+	g.PauseAll()
+	for i, fc := range clocks {
+		fc.Set(first.Add(interval + time.Second))
+		if i < 2 {
+			expectNothing(t, g.tickers[i].C)
+			if next := g.tickers[i].NextTick(); !next.IsZero() {
+				t.Errorf("expected no next tick while paused, but got %v", next)
+			}
+		}
+	}
+	// The removed ticker is not paused.
+	receive(t, removed.C)
+
+	g.ResumeAll()
+	next := first.Add(2 * interval)
+	for i, fc := range clocks[:2] {
+		fc.expectTimer(t, next)
+		fc.Set(next)
+		if tick := receive(t, g.tickers[i].C); !tick.Equal(next) {
+			t.Errorf("expected tick at %v, but got %v", next, tick)
+		}
+	}
+
+	tickers := g.tickers
+	g.StopAll()
+	for _, st := range tickers {
+		if !st.Stopped() {
+			t.Error("expected ticker to be stopped")
+		}
+	}
+	if removed.Stopped() {
+		t.Error("expected removed ticker to keep running")
+	}
+}
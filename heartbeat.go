@@ -0,0 +1,28 @@
+package sticker
+
+import "time"
+
+// WithHeartbeat makes a DetailedTicker deliver a heartbeat every d while no tick fires, e.g. so that
+// a watchdog sees activity even if the next tick is far away. Heartbeats are marked as [Tick.Heartbeat]
+// and fire d after the last tick or heartbeat unless a tick is due before, i.e. ticks take precedence.
+// Heartbeats are only delivered on C and are not passed to subscribers or counted by Stats.
+// Tickers delivering plain times ignore this option since heartbeats could not be told apart from ticks.
+// A non-positive d disables heartbeats.
+func WithHeartbeat(d time.Duration) Option {
+	return func(st *ScheduledTicker) {
+		st.heartbeat = d
+	}
+}
+
+// heartbeatAt delivers a heartbeat scheduled at scheduled.
+func (st *ScheduledTicker) heartbeatAt(now, scheduled time.Time) {
+	t := Tick{
+		Scheduled: scheduled,
+		Actual:    now,
+		Heartbeat: true,
+	}
+	if st.loc != nil {
+		t.Scheduled, t.Actual = t.Scheduled.In(st.loc), t.Actual.In(st.loc)
+	}
+	st.deliver(t, false)
+}
@@ -0,0 +1,55 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHeartbeat(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	first := start.Add(25 * time.Second)
+	fc := newFakeClock(start)
+	dt := NewDetailed(first, time.Minute, WithHeartbeat(10*time.Second), WithClock(fc))
+	defer dt.Stop()
+
+	// Heartbeats fire every 10s until the tick at 25s which would be due before the next one.
+	for _, want := range []time.Time{start.Add(10 * time.Second), start.Add(20 * time.Second)} {
+		fc.expectTimer(t, want)
+		fc.Set(want)
+		if tick := receive(t, dt.C); !tick.Heartbeat || !tick.Actual.Equal(want) {
+			t.Errorf("expected heartbeat at %v, but got %+v", want, tick)
+		}
+	}
+	fc.expectTimer(t, first)
+	fc.Set(first)
+	if tick := receive(t, dt.C); tick.Heartbeat || tick.Seq != 1 || !tick.Actual.Equal(first) {
+		t.Errorf("expected tick at %v, but got %+v", first, tick)
+	}
+
+	// Heartbeats continue after the tick.
+	for i := 1; i <= 5; i++ {
+		want := first.Add(time.Duration(i) * 10 * time.Second)
+		fc.expectTimer(t, want)
+		fc.Set(want)
+		if tick := receive(t, dt.C); !tick.Heartbeat || !tick.Actual.Equal(want) {
+			t.Errorf("expected heartbeat at %v, but got %+v", want, tick)
+		}
+	}
+	next := first.Add(time.Minute)
+	fc.expectTimer(t, next)
+	fc.Set(next)
+	if tick := receive(t, dt.C); tick.Heartbeat || tick.Seq != 2 {
+		t.Errorf("expected tick at %v, but got %+v", next, tick)
+	}
+}
+
+func TestHeartbeatIgnoredOnC(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(start)
+	st := New(start.Add(time.Hour), time.Minute, WithHeartbeat(time.Second), WithClock(fc))
+	defer st.Stop()
+
+	fc.expectTimer(t, start.Add(time.Hour))
+	fc.Set(start.Add(time.Minute))
+	expectNothing(t, st.C)
+}
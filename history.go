@@ -0,0 +1,56 @@
+package sticker
+
+import "time"
+
+// defaultHistorySize is the number of ticks kept for History unless changed by WithHistorySize.
+const defaultHistorySize = 8
+
+// WithHistorySize changes the number of ticks kept for [ScheduledTicker.History] to n.
+// A non-positive n disables the history.
+func WithHistorySize(n int) Option {
+	return func(st *ScheduledTicker) {
+		if n < 0 {
+			n = 0
+		}
+		st.historySize = n
+	}
+}
+
+// History returns the times of the most recent ticks, both delivered and dropped, oldest first.
+// By default the last 8 ticks are kept; use WithHistorySize to change this.
+// It helps to diagnose irregular spacing of ticks after the fact.
+func (st *ScheduledTicker) History() []time.Time {
+	if st == nil {
+		return nil
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return st.history.values()
+}
+
+// ring is a fixed-size buffer of the most recent times.
+type ring struct {
+	buf  []time.Time
+	next int  // Index the next time is stored at.
+	full bool // Whether buf has wrapped around.
+}
+
+func (r *ring) add(t time.Time) {
+	if len(r.buf) == 0 {
+		return
+	}
+	r.buf[r.next] = t
+	r.next++
+	if r.next == len(r.buf) {
+		r.next = 0
+		r.full = true
+	}
+}
+
+// values returns a copy of the times in r, oldest first.
+func (r *ring) values() []time.Time {
+	if !r.full {
+		return append([]time.Time(nil), r.buf[:r.next]...)
+	}
+	return append(append([]time.Time(nil), r.buf[r.next:]...), r.buf[:r.next]...)
+}
@@ -0,0 +1,71 @@
+package sticker
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestHistory(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	st := New(first, interval, WithHistorySize(3), WithClock(fc))
+	defer st.Stop()
+
+	if h := st.History(); len(h) != 0 {
+		t.Errorf("expected empty history, but got %v", h)
+	}
+
+	var ticks []time.Time
+	for i := 0; i < 5; i++ {
+		next := first.Add(time.Duration(i) * interval)
+		fc.expectTimer(t, next)
+		// Fire each tick a bit late, the history records when they actually fired.
+		fired := next.Add(time.Duration(i) * time.Second)
+		fc.Set(fired)
+		ticks = append(ticks, fired)
+		if i == 1 {
+			// Make room for one more tick, all others are dropped while C is full.
+			receive(t, st.C)
+		}
+		if i == 2 {
+			fc.expectTimer(t, first.Add(3*interval))
+			if h, want := st.History(), ticks; !reflect.DeepEqual(h, want) {
+				t.Errorf("expected history %v, but got %v", want, h)
+			}
+		}
+	}
+	fc.expectTimer(t, first.Add(5*interval))
+
+	if h, want := st.History(), ticks[2:]; !reflect.DeepEqual(h, want) {
+		t.Errorf("expected history %v, but got %v", want, h)
+	}
+}
+
+func TestHistoryDisabled(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(first)
+	st := New(first, time.Minute, WithHistorySize(0), WithClock(fc))
+	defer st.Stop()
+
+	st.Fire()
+	receive(t, st.C)
+	if h := st.History(); len(h) != 0 {
+		t.Errorf("expected no history, but got %v", h)
+	}
+}
+
+func TestHistoryDefaultSize(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(first)
+	st := New(first.Add(time.Hour), time.Minute, WithClock(fc))
+	defer st.Stop()
+
+	for i := 0; i < defaultHistorySize+2; i++ {
+		st.Fire()
+	}
+	if h := st.History(); len(h) != defaultHistorySize {
+		t.Errorf("expected %d ticks in history, but got %d", defaultHistorySize, len(h))
+	}
+}
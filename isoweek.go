@@ -0,0 +1,68 @@
+package sticker
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// week is the nominal interval of weekly schedules.
+const week = 7 * day
+
+// NewISOWeekly returns a new ScheduledTicker that ticks once every ISO 8601 week on weekday at the time
+// of day at in loc, e.g. for weekly reports keyed on the ISO week of the tick. ISO weeks start on Monday,
+// so a tick on Sunday belongs to the week of the preceding Monday even across the turn of the year,
+// where the ISO week year can differ from the calendar year. The time of day is kept across
+// daylight saving time changes like NewEveryDay does.
+// The duration at must be within [0, 24h) and loc must not be nil; if not, NewISOWeekly will panic.
+// Stop the ticker to release associated resources.
+func NewISOWeekly(weekday time.Weekday, at time.Duration, loc *time.Location, opts ...Option) *ScheduledTicker {
+	if at < 0 || at >= day {
+		panic(errors.New("time of day out of range for NewISOWeekly ScheduledTicker"))
+	}
+	if loc == nil {
+		panic(errors.New("nil location for NewISOWeekly ScheduledTicker"))
+	}
+	ticker := newChanTicker(context.Background(), opts)
+	ticker.startSchedule(isoWeekly{weekday: weekday, at: at, loc: loc}, week)
+	return ticker
+}
+
+// isoWeekly is the schedule of a tick on the same weekday and time of day of every ISO week.
+type isoWeekly struct {
+	weekday time.Weekday
+	at      time.Duration // Time of day as offset from midnight.
+	loc     *time.Location
+}
+
+func (w isoWeekly) next(t time.Time) time.Time {
+	n := w.on(t, 0)
+	if !n.After(t) {
+		n = w.on(t, 1)
+	}
+	return n
+}
+
+func (w isoWeekly) prev(t time.Time) time.Time {
+	p := w.on(t, 0)
+	if p.After(t) {
+		p = w.on(t, -1)
+	}
+	return p
+}
+
+// on returns the tick in the ISO week that is weeks after the ISO week of t.
+func (w isoWeekly) on(t time.Time, weeks int) time.Time {
+	year, wk := t.In(w.loc).ISOWeek()
+	y, m, d := isoWeekStart(year, wk, w.loc).Date()
+	// Monday is the first day of an ISO week.
+	offset := (int(w.weekday) + 6) % 7
+	return dateAt(y, m, d+7*weeks+offset, w.at, w.loc)
+}
+
+// isoWeekStart returns the midnight of the Monday starting the ISO week wk of year in loc.
+func isoWeekStart(year, wk int, loc *time.Location) time.Time {
+	// January 4th is always in the first ISO week.
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, loc)
+	return jan4.AddDate(0, 0, 7*(wk-1)-(int(jan4.Weekday())+6)%7)
+}
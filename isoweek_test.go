@@ -0,0 +1,67 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestISOWeekly(t *testing.T) {
+	loc := time.FixedZone("UTC+1", 60*60)
+	at := 8 * time.Hour
+	fc := newFakeClock(time.Date(2026, 12, 20, 12, 0, 0, 0, loc))
+	st := NewISOWeekly(time.Sunday, at, loc, WithClock(fc))
+	defer st.Stop()
+
+	for _, tc := range []struct {
+		want       time.Time
+		year, week int
+	}{
+		{time.Date(2026, 12, 27, 8, 0, 0, 0, loc), 2026, 52},
+		// The last ISO week of 2026 ends in 2027.
+		{time.Date(2027, 1, 3, 8, 0, 0, 0, loc), 2026, 53},
+		{time.Date(2027, 1, 10, 8, 0, 0, 0, loc), 2027, 1},
+	} {
+		fc.expectTimer(t, tc.want)
+		fc.Set(tc.want)
+		tick := receive(t, st.C)
+		if !tick.Equal(tc.want) {
+			t.Errorf("expected tick at %v, but got %v", tc.want, tick)
+		}
+		if year, week := tick.In(loc).ISOWeek(); year != tc.year || week != tc.week {
+			t.Errorf("expected tick in ISO week %d-W%02d, but got %d-W%02d", tc.year, tc.week, year, week)
+		}
+	}
+}
+
+func TestISOWeeklyYearStart(t *testing.T) {
+	// The first ISO week of 2025 starts on Monday, 2024-12-30.
+	w := isoWeekly{weekday: time.Monday, loc: time.UTC}
+	for _, tc := range []struct {
+		now, want time.Time
+	}{
+		{time.Date(2024, 12, 24, 0, 0, 0, 0, time.UTC), time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)},
+	} {
+		if next := w.next(tc.now); !next.Equal(tc.want) {
+			t.Errorf("expected next tick after %v at %v, but got %v", tc.now, tc.want, next)
+		}
+		if prev := w.prev(tc.want); !prev.Equal(tc.want) {
+			t.Errorf("expected previous tick at %v, but got %v", tc.want, prev)
+		}
+	}
+}
+
+func TestISOWeekStart(t *testing.T) {
+	for year := 2000; year < 2040; year++ {
+		for _, wk := range []int{1, 2, 52} {
+			start := isoWeekStart(year, wk, time.UTC)
+			if start.Weekday() != time.Monday {
+				t.Errorf("expected %d-W%02d to start on Monday, but got %v", year, wk, start.Weekday())
+			}
+			if y, w := start.ISOWeek(); y != year || w != wk {
+				t.Errorf("expected %v to be in %d-W%02d, but got %d-W%02d", start, year, wk, y, w)
+			}
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package sticker
+
+import "time"
+
+// WithMinLifetime keeps the goroutine of the ticker and its timer alive until at least d after the ticker
+// was created, even if it is stopped before, e.g. to smooth out the churn of tickers that are created and
+// stopped within milliseconds by a rapidly toggled feature. Stop still takes effect immediately: no more
+// ticks are delivered and Stopped reports true. Only the teardown of the goroutine is deferred.
+// A non-positive d disables the minimum lifetime.
+func WithMinLifetime(d time.Duration) Option {
+	return func(st *ScheduledTicker) {
+		st.minLifetime = d
+	}
+}
+
+// linger waits with timer until the minimum lifetime of st has passed.
+func (st *ScheduledTicker) linger(timer Timer) {
+	if st.minLifetime <= 0 {
+		return
+	}
+	remaining := st.created.Add(st.minLifetime).Sub(st.clock.Now())
+	if remaining <= 0 {
+		return
+	}
+	stopTimer(timer)
+	timer.Reset(remaining)
+	<-timer.C()
+}
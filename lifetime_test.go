@@ -0,0 +1,39 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMinLifetime(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(start)
+	st := New(start.Add(time.Minute), time.Minute, WithMinLifetime(time.Second), WithClock(fc))
+
+	fc.Set(start.Add(10 * time.Millisecond))
+	st.Stop()
+	if !st.Stopped() {
+		t.Error("expected ticker to be stopped")
+	}
+	// The goroutine lingers with its timer until the minimum lifetime has passed.
+	fc.expectTimer(t, start.Add(time.Second))
+	if n := st.LiveTimers(); n != 1 {
+		t.Errorf("expected goroutine to keep its timer, but got %d live timers", n)
+	}
+	expectNothing(t, st.C)
+	select {
+	case <-st.Done():
+		t.Error("expected Done not to be closed while the goroutine lingers")
+	default:
+	}
+
+	fc.Set(start.Add(time.Second))
+	select {
+	case <-st.Done():
+	case <-time.After(time.Second):
+		t.Fatal("expected goroutine to exit after the minimum lifetime")
+	}
+	if n := st.LiveTimers(); n != 0 {
+		t.Errorf("expected no live timers after Done, but got %d", n)
+	}
+}
@@ -0,0 +1,112 @@
+package sticker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// NewManual returns a new ScheduledTicker like New that does not run a goroutine of its own.
+// Instead the ticker only advances when [ScheduledTicker.Tick] is called, e.g. from the
+// update of a single-threaded event loop or simulation. Options that depend on a running
+// loop or the clock, like WithReadySignal, WithMaxInitialDelay, WithJitterFraction or WithClockOffset,
+// have no effect. The current time of the ticker, e.g. for Reset or Until, is the time last passed to Tick.
+// A zero first means the time of the first Tick, so like with New the first tick arrives one interval after it.
+// The duration interval must be greater than zero; if not, NewManual will panic.
+func NewManual(first time.Time, interval time.Duration, opts ...Option) *ScheduledTicker {
+	if interval <= 0 {
+		panic(errors.New("non-positive interval for NewManual ScheduledTicker"))
+	}
+	ticker := newChanTicker(context.Background(), opts)
+	ticker.manual = true
+	// Until the first Tick the clock is at the zero time so that first is still due.
+	ticker.clock = &manualClock{}
+	// NOTE: set the schedule directly since it is not a reconfiguration like a Reset.
+	ticker.mu.Lock()
+	if first.IsZero() {
+		// The current time is only known with the first Tick, which starts the schedule.
+		ticker.interval = interval
+	} else {
+		ticker.setSchedule(ticker.clock.Now(), first, interval, ticker.missed)
+	}
+	ticker.mu.Unlock()
+	return ticker
+}
+
+// Tick advances a ticker created by NewManual to now. If a tick is due at now it is fired like the
+// running ticker would, i.e. delivered on C and to all subscribers, and Tick returns true together
+// with the point in time the tick was scheduled for. Ticks missed since the last call are skipped.
+// Otherwise, or if the ticker is stopped, Tick returns false and the zero time.
+// Tick must not be called on tickers that are not created by NewManual.
+func (st *ScheduledTicker) Tick(now time.Time) (bool, time.Time) {
+	if st == nil {
+		return false, time.Time{}
+	}
+	st.mu.Lock()
+	st.clock.(*manualClock).set(now)
+	if st.first.IsZero() && st.sched == nil {
+		// The schedule of a zero first starts now.
+		st.setSchedule(now, now, st.interval, st.missed)
+	}
+	next, due := st.next, st.fireAt
+	st.mu.Unlock()
+	if st.ctx().Err() != nil || next.IsZero() || now.Before(due) {
+		return false, time.Time{}
+	}
+	if !st.tick(now, next) {
+		return false, time.Time{}
+	}
+	return true, next
+}
+
+// manualClock is the clock of a manual ticker that is set on every Tick.
+type manualClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *manualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *manualClock) set(now time.Time) {
+	c.mu.Lock()
+	c.now = now
+	c.mu.Unlock()
+}
+
+// NewTimer is never called since a manual ticker has no loop.
+func (c *manualClock) NewTimer(time.Duration) Timer {
+	panic(errors.New("no timers on the clock of a manual ScheduledTicker"))
+}
+
+// ManualTicker is a ScheduledTicker without a schedule whose ticks are triggered explicitly by FireAt,
+// e.g. to unit test code handling the ticks of a ScheduledTicker without sleeping. Pass its
+// ScheduledTicker to the code under test in place of one created by New.
+type ManualTicker struct {
+	*ScheduledTicker
+}
+
+// NewManualTicker returns a new ManualTicker configured by opts. Unlike a ticker created by NewManual
+// it does not tick on its own at all. Options that depend on a schedule or a running loop have no effect.
+// Stop the ticker to release associated resources.
+func NewManualTicker(opts ...Option) *ManualTicker {
+	ticker := newChanTicker(context.Background(), opts)
+	ticker.manual = true
+	ticker.clock = &manualClock{}
+	return &ManualTicker{ticker}
+}
+
+// FireAt delivers a tick at t on C and to all subscribers like a ticker created by New would at t,
+// so the tick is dropped if C is full. The current time of the ticker becomes t, which is also the
+// time of the ticks of [ScheduledTicker.Fire]. FireAt does nothing if the ticker is stopped.
+func (mt *ManualTicker) FireAt(t time.Time) {
+	if mt == nil || mt.ctx().Err() != nil {
+		return
+	}
+	mt.clock.(*manualClock).set(t)
+	mt.fireExternal(t)
+}
@@ -0,0 +1,135 @@
+package sticker
+
+import (
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestManual(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	goroutines := runtime.NumGoroutine()
+	st := NewManual(first, interval)
+	defer st.Stop()
+	if n := runtime.NumGoroutine(); n > goroutines {
+		t.Errorf("expected no goroutine to be started, but got %d more", n-goroutines)
+	}
+
+	for _, step := range []struct {
+		now   time.Time
+		fired bool
+		tick  time.Time
+	}{
+		{now: first.Add(-time.Second)},
+		{now: first, fired: true, tick: first},
+		{now: first.Add(30 * time.Second)},
+		{now: first.Add(interval + time.Second), fired: true, tick: first.Add(interval)},
+		{now: first.Add(interval + 2*time.Second)},
+		// Missed ticks are skipped.
+		{now: first.Add(5*interval + time.Second), fired: true, tick: first.Add(2 * interval)},
+		{now: first.Add(5*interval + 2*time.Second)},
+		{now: first.Add(6 * interval), fired: true, tick: first.Add(6 * interval)},
+	} {
+		fired, tick := st.Tick(step.now)
+		if fired != step.fired || !tick.Equal(step.tick) {
+			t.Errorf("expected Tick(%v) = %v, %v, but got %v, %v", step.now, step.fired, step.tick, fired, tick)
+		}
+		if fired {
+			if got := receive(t, st.C); !got.Equal(step.now) {
+				t.Errorf("expected tick at %v on C, but got %v", step.now, got)
+			}
+		}
+	}
+
+	st.Reset(first.Add(10*interval), interval)
+	if fired, _ := st.Tick(first.Add(9 * interval)); fired {
+		t.Error("expected no tick before the new first start")
+	}
+	if fired, _ := st.Tick(first.Add(10 * interval)); !fired {
+		t.Error("expected tick at the new first start")
+	}
+	receive(t, st.C)
+
+	st.Stop()
+	if fired, _ := st.Tick(first.Add(11 * interval)); fired {
+		t.Error("expected no tick after Stop")
+	}
+}
+
+func TestManualTicker(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	mt := NewManualTicker()
+	defer mt.Stop()
+	sub := mt.Subscribe()
+
+	expectNothing(t, mt.C)
+	mt.FireAt(now)
+	if tick := receive(t, mt.C); !tick.Equal(now) {
+		t.Errorf("expected tick at %v, but got %v", now, tick)
+	}
+	if tick := receive(t, sub); !tick.Equal(now) {
+		t.Errorf("expected tick at %v for subscriber, but got %v", now, tick)
+	}
+
+	// Fire of ScheduledTicker stays available and fires at the current time of the ticker.
+	mt.Fire()
+	if tick := receive(t, mt.C); !tick.Equal(now) {
+		t.Errorf("expected tick of Fire at %v, but got %v", now, tick)
+	}
+	receive(t, sub)
+
+	// Like on any ticker a tick is dropped while C is full.
+	mt.FireAt(now.Add(time.Second))
+	mt.FireAt(now.Add(2 * time.Second))
+	if tick := receive(t, mt.C); !tick.Equal(now.Add(time.Second)) {
+		t.Errorf("expected tick at %v, but got %v", now.Add(time.Second), tick)
+	}
+	expectNothing(t, mt.C)
+	if stats := mt.Stats(); stats.Delivered != 3 || stats.Dropped != 1 {
+		t.Errorf("expected 3 delivered and 1 dropped tick, but got %+v", stats)
+	}
+
+	mt.Stop()
+	mt.FireAt(now.Add(3 * time.Second))
+	expectNothing(t, mt.C)
+}
+
+func TestManualNoReconfig(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	reconfigured := false
+	onReconfig := WithOnReconfig(func(_, _ Config) { reconfigured = true })
+	for name, st := range map[string]*ScheduledTicker{
+		"NewManual": NewManual(first, time.Minute, onReconfig),
+		"NewTicker": NewReplayClock(first).NewTicker(first, time.Minute, onReconfig),
+	} {
+		if n := st.ReconfigCount(); n != 0 {
+			t.Errorf("%s: expected no reconfiguration after construction, but got %d", name, n)
+		}
+		st.Stop()
+	}
+	if reconfigured {
+		t.Error("expected the reconfiguration callback not to be called by construction")
+	}
+}
+
+func TestManualZeroFirst(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	st := NewManual(time.Time{}, interval)
+	defer st.Stop()
+
+	// The schedule starts with the first Tick like New starts it at the current time.
+	if fired, _ := st.Tick(now); fired {
+		t.Error("expected no tick at the first Tick")
+	}
+	if next := st.NextTick(); !next.Equal(now.Add(interval)) {
+		t.Errorf("expected next tick at %v, but got %v", now.Add(interval), next)
+	}
+	if fired, _ := st.Tick(now.Add(interval - time.Second)); fired {
+		t.Error("expected no tick before one interval passed")
+	}
+	if fired, tick := st.Tick(now.Add(interval)); !fired || !tick.Equal(now.Add(interval)) {
+		t.Errorf("expected tick at %v, but got %v, %v", now.Add(interval), fired, tick)
+	}
+}
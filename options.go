@@ -0,0 +1,237 @@
+package sticker
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// Option configures optional behavior of a ScheduledTicker on construction.
+type Option func(*ScheduledTicker)
+
+// WithAutoPause makes the ticker only run while at least one subscriber is attached via
+// [ScheduledTicker.Subscribe]. While there is none no timer is held and no ticks are
+// delivered, not even on C. Attaching the first subscriber resumes the ticker aligned to its schedule.
+func WithAutoPause() Option {
+	return func(st *ScheduledTicker) {
+		st.autoPause = true
+	}
+}
+
+// WithMaxInitialDelay limits how long the ticker arms a single timer for to d.
+// If the next tick is further away the timer is armed for d and re-armed on expiry until the tick is due.
+// Since regular ticks are never further apart than the interval this only affects the first tick
+// after construction or Reset. It avoids a single huge timer for far-future starts that can become
+// imprecise across clock changes. A non-positive d disables the limit.
+func WithMaxInitialDelay(d time.Duration) Option {
+	return func(st *ScheduledTicker) {
+		st.maxDelay = d
+	}
+}
+
+// WithReadySignal holds back all ticks until ready is closed or receives a value.
+// The ticker then ticks immediately and continues with the next tick of its schedule.
+// This avoids ticks while the application is still starting up.
+func WithReadySignal(ready <-chan struct{}) Option {
+	return func(st *ScheduledTicker) {
+		st.ready = ready
+	}
+}
+
+// WithGate makes the ticker only fire ticks while gate enables it, e.g. while a leader lease is held.
+// Receiving true from gate enables the ticks, receiving false disables them; initially they are disabled.
+// Unlike WithReadySignal the gate can be toggled any number of times. Ticks of the schedule while disabled
+// are skipped, which is counted in [Stats.Skipped], and no catch-up tick fires on enabling. A closed gate
+// disables the ticks for good. WithGate has no effect on tickers created by NewManual.
+func WithGate(gate <-chan bool) Option {
+	return func(st *ScheduledTicker) {
+		st.gate = gate
+	}
+}
+
+// WithCoalesceNewest changes what happens to a tick while the previous one was not yet received.
+// By default the new tick is dropped. With this option the pending tick is dropped instead so that
+// a reader that caught up gets a tick instantly and a reader that fell behind always gets the freshest tick.
+// It is the same as WithDropPolicy(DropOldest).
+func WithCoalesceNewest() Option {
+	return WithDropPolicy(DropOldest)
+}
+
+// DropPolicy defines which tick a ticker drops when its channel is full because the consumer lags.
+type DropPolicy int
+
+const (
+	// DropNewest drops the new tick and keeps the pending ones.
+	DropNewest DropPolicy = iota
+
+	// DropOldest drops the oldest pending tick to make room for the new one.
+	DropOldest
+)
+
+// WithDropPolicy sets which tick the ticker drops when C is full. The default is DropNewest.
+func WithDropPolicy(p DropPolicy) Option {
+	return func(st *ScheduledTicker) {
+		st.coalesce = p == DropOldest
+	}
+}
+
+// WithBufferSize sets the capacity of C to n ticks, e.g. for consumers that occasionally take
+// longer than an interval but must catch up on the ticks in between. Ticks are only dropped
+// according to the DropPolicy once n ticks are pending. A value of n below 1 keeps the default of 1.
+func WithBufferSize(n int) Option {
+	return func(st *ScheduledTicker) {
+		if n >= 1 {
+			st.bufferSize = n
+		}
+	}
+}
+
+// WithJitterFraction spreads ticks randomly by up to ±f*interval around the points in time of the schedule.
+// Since the jitter is proportional to the interval it scales when the interval is changed by Reset.
+// The schedule itself is not affected, i.e. jitter does not accumulate over ticks.
+// f must be within [0, 1]; if not, WithJitterFraction will panic.
+func WithJitterFraction(f float64) Option {
+	if f < 0 || f > 1 {
+		panic(errors.New("jitter fraction out of range [0, 1] for WithJitterFraction"))
+	}
+	return func(st *ScheduledTicker) {
+		st.jitterFraction = f
+	}
+}
+
+// WithClockOffset makes the ticker treat the current time as being off by offset from the local clock,
+// e.g. to correct local clock skew by an offset obtained via NTP from a trusted time source. Both the
+// calculation of the schedule and the delivered ticks use the corrected time. This keeps ticks aligned
+// across machines whose local clocks differ.
+func WithClockOffset(offset time.Duration) Option {
+	return func(st *ScheduledTicker) {
+		st.offset = offset
+	}
+}
+
+// WithGuaranteedFirst makes the ticker never drop the first n ticks on C. Instead of dropping
+// a tick the ticker waits until the previous one was received, so consumers that are slow to
+// start up still see all of them. Later ticks are dropped or coalesced as usual.
+// While waiting no further ticks are produced. Subscribers are not affected.
+// A non-positive n disables the guarantee.
+func WithGuaranteedFirst(n int) Option {
+	return func(st *ScheduledTicker) {
+		if n > 0 {
+			st.guaranteed = uint64(n)
+		}
+	}
+}
+
+// WithBlocking makes the ticker wait until a tick was received from C instead of dropping it when C
+// is full, e.g. for pipelines that prefer backpressure over losing ticks. This applies to every tick
+// including those of Fire, which then blocks as well. While waiting no further ticks are produced, so
+// ticks whose time passed meanwhile are handled like any other missed tick, i.e. skipped unless the
+// ticker backfills them with BackfillMissed. Subscribers are not affected. Stop ends the waiting.
+func WithBlocking() Option {
+	return func(st *ScheduledTicker) {
+		st.blocking = true
+	}
+}
+
+// WithLocation delivers the ticks as times in loc, e.g. time.UTC for consistent logging.
+// This only affects the presentation of the ticks and not the schedule.
+// loc must not be nil; if it is, WithLocation will panic.
+func WithLocation(loc *time.Location) Option {
+	if loc == nil {
+		panic(errors.New("nil location for WithLocation"))
+	}
+	return func(st *ScheduledTicker) {
+		st.loc = loc
+	}
+}
+
+// WithPredicate makes the ticker only fire a tick if pred returns true for the point in time it is scheduled for,
+// e.g. to only tick during trading hours. Otherwise the tick is skipped, which is counted in [Stats.Skipped],
+// and the schedule continues with the next tick. Skipped ticks do not leave gaps in [Tick.Seq].
+// pred is called from the goroutine of the ticker and should return quickly.
+func WithPredicate(pred func(scheduled time.Time) bool) Option {
+	return func(st *ScheduledTicker) {
+		st.predicate = pred
+	}
+}
+
+// WithDecayingJitter spreads ticks randomly by up to ±initial around the points in time of the schedule
+// right after the ticker is created and shrinks this linearly to zero over window. After window has
+// passed the ticks are aligned to the schedule again. This de-synchronizes a fleet of tickers that all
+// start at the same time, e.g. after a mass restart, without permanently losing their alignment.
+// It adds to the jitter of WithJitterFraction.
+func WithDecayingJitter(initial, window time.Duration) Option {
+	return func(st *ScheduledTicker) {
+		st.jitterDecay.initial, st.jitterDecay.window = initial, window
+	}
+}
+
+// decayingJitter returns the maximum jitter of WithDecayingJitter for the tick at next.
+func (st *ScheduledTicker) decayingJitter(next time.Time) time.Duration {
+	d := st.jitterDecay
+	if d.initial <= 0 || d.window <= 0 {
+		return 0
+	}
+	elapsed := next.Sub(st.created)
+	if elapsed >= d.window {
+		return 0
+	}
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	return time.Duration(float64(d.initial) * float64(d.window-elapsed) / float64(d.window))
+}
+
+// WithGracePeriod makes the ticker still fire the first tick of a schedule whose first start is in the past
+// by no more than d, instead of skipping it in favor of the next tick of the schedule. The tick fires
+// immediately. This avoids losing the first tick to scheduling latency when the first start is about now.
+// It applies on construction as well as on Reset. A non-positive d disables the grace period.
+func WithGracePeriod(d time.Duration) Option {
+	return func(st *ScheduledTicker) {
+		st.grace = d
+	}
+}
+
+// WithCloseOnStop makes the ticker close C once it is stopped, either by Stop or because its context is done,
+// so that consumers can range over C. No tick is delivered on C after the ticker is stopped, so a receive
+// only yields the zero value together with ok == false after C was closed, never as a tick. This applies to
+// the C of a DetailedTicker as well. Subscribers are not closed. Since a closed C cannot deliver ticks
+// anymore, stopping such a ticker is final: unlike other tickers it is not re-armed by Reset.
+func WithCloseOnStop() Option {
+	return func(st *ScheduledTicker) {
+		st.closeOnStop = true
+	}
+}
+
+// closeWhenStopped makes st call closeC once it is stopped and guards the delivery on C against
+// sending after that.
+func (st *ScheduledTicker) closeWhenStopped(closeC func()) {
+	// A closed channel cannot be reopened by a restart.
+	st.final = true
+	deliver := st.deliver
+	var mu sync.RWMutex
+	st.deliver = func(t Tick, wait bool) bool {
+		mu.RLock()
+		defer mu.RUnlock()
+		if st.ctx().Err() != nil {
+			return false
+		}
+		return deliver(t, wait)
+	}
+	go func() {
+		<-st.ctx().Done()
+		// NOTE: deliveries in progress hold mu, so none can send on the closed channel.
+		mu.Lock()
+		closeC()
+		mu.Unlock()
+	}()
+}
+
+// WithMaxFutureStart makes TryNew and TryReset return ErrFarFuture if the first start lies more than d
+// in the future, e.g. to catch a start in the year 2345 caused by a bug. New and Reset are not affected.
+// A non-positive d disables the check, which is the default.
+func WithMaxFutureStart(d time.Duration) Option {
+	return func(st *ScheduledTicker) {
+		st.maxFuture = d
+	}
+}
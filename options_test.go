@@ -0,0 +1,513 @@
+package sticker
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMaxInitialDelay(t *testing.T) {
+	first := time.Date(2345, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(first.Add(-150 * time.Minute))
+	st := New(first, time.Minute, WithClock(fc), WithMaxInitialDelay(time.Hour))
+	defer st.Stop()
+
+	for i := 0; i < 2; i++ {
+		fc.expectTimer(t, fc.Now().Add(time.Hour))
+		fc.Advance(time.Hour)
+		expectNothing(t, st.C)
+	}
+
+	fc.expectTimer(t, first)
+	fc.Advance(30 * time.Minute)
+	if tick := receive(t, st.C); !tick.Equal(first) {
+		t.Errorf("expected tick at %v, but got %v", first, tick)
+	}
+}
+
+func TestReadySignal(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := 10 * time.Millisecond
+	fc := newFakeClock(first)
+	ready := make(chan struct{})
+	st := New(first, interval, WithClock(fc), WithReadySignal(ready))
+	defer st.Stop()
+
+	for i := 0; i < 3; i++ {
+		fc.Advance(interval)
+		expectNothing(t, st.C)
+	}
+
+	fc.Advance(interval / 2)
+	close(ready)
+	if tick, want := receive(t, st.C), fc.Now(); !tick.Equal(want) {
+		t.Errorf("expected tick at ready signal %v, but got %v", want, tick)
+	}
+	fc.expectTimer(t, first.Add(4*interval))
+}
+
+func TestCoalesceNewest(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+
+	for _, tc := range []struct {
+		name string
+		opts []Option
+		want time.Time
+	}{
+		{
+			name: "dropNewest",
+			want: first,
+		},
+		{
+			name: "coalesceNewest",
+			opts: []Option{WithCoalesceNewest()},
+			want: first.Add(2 * interval),
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			fc := newFakeClock(first.Add(-time.Second))
+			st := New(first, interval, append(tc.opts, WithClock(fc))...)
+			defer st.Stop()
+			sub := st.Subscribe()
+
+			for i := 0; i < 3; i++ {
+				next := first.Add(time.Duration(i) * interval)
+				fc.expectTimer(t, next)
+				fc.Set(next)
+			}
+			fc.expectTimer(t, first.Add(3*interval))
+
+			if tick := receive(t, st.C); !tick.Equal(tc.want) {
+				t.Errorf("expected tick at %v, but got %v", tc.want, tick)
+			}
+			if tick := receive(t, sub); !tick.Equal(tc.want) {
+				t.Errorf("expected subscriber tick at %v, but got %v", tc.want, tick)
+			}
+		})
+	}
+}
+
+func TestJitterFraction(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fraction := 0.1
+	fc := newFakeClock(first.Add(-time.Hour))
+	dt := NewDetailed(first, time.Minute, WithClock(fc), WithJitterFraction(fraction))
+	defer dt.Stop()
+
+	check := func(interval time.Duration, n int) {
+		t.Helper()
+		window := time.Duration(fraction * float64(interval))
+		for i := 0; i < n; i++ {
+			fc.Set(fc.awaitTimer(t))
+			tick := receive(t, dt.C)
+			if drift := tick.Drift(); drift < -window || drift > window {
+				t.Errorf("tick at %v is outside of ±%v around %v", tick.Actual, window, tick.Scheduled)
+			}
+		}
+	}
+
+	check(time.Minute, 10)
+	interval := time.Hour
+	dt.Reset(fc.Now(), interval)
+	// Wait for the timer to be re-armed for the new interval.
+	for fc.awaitTimer(t).Before(fc.Now().Add(interval / 2)) {
+		time.Sleep(time.Millisecond)
+	}
+	check(interval, 10)
+}
+
+func TestJitterNextTick(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(first.Add(-time.Hour))
+	dt := NewDetailed(first, time.Minute, WithClock(fc), WithJitterFraction(0.5))
+	defer dt.Stop()
+
+	jittered := false
+	for i := 0; i < 10; i++ {
+		next := dt.NextTick()
+		if until := dt.Until(); until != next.Sub(fc.Now()) {
+			t.Errorf("expected next tick in %v, but got %v", next.Sub(fc.Now()), until)
+		}
+		fc.expectTimer(t, next)
+		fc.Set(next)
+		tick := receive(t, dt.C)
+		if !tick.Actual.Equal(next) {
+			t.Errorf("expected tick to arrive at %v, but got %v", next, tick.Actual)
+		}
+		jittered = jittered || !tick.Scheduled.Equal(next)
+		fc.expectTimer(t, dt.NextTick())
+	}
+	if !jittered {
+		t.Error("expected next tick to include jitter")
+	}
+}
+
+func TestDecayingJitter(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	initial, window := 30*time.Second, 5*time.Minute
+	fc := newFakeClock(first)
+	dt := NewDetailed(first, interval, WithClock(fc), WithDecayingJitter(initial, window))
+	defer dt.Stop()
+
+	// The largest jitter of many samples shrinks from tick to tick until it is gone.
+	prev := initial + 1
+	for i := 1; i <= 6; i++ {
+		next := first.Add(time.Duration(i) * interval)
+		limit := dt.decayingJitter(next)
+		var want time.Duration
+		if i < 5 {
+			want = initial * time.Duration(5-i) / 5
+		}
+		if limit != want {
+			t.Errorf("expected jitter of tick %d within ±%v, but got ±%v", i, want, limit)
+		}
+		var largest time.Duration
+		for j := 0; j < 1000; j++ {
+			d := dt.jitter(next, interval)
+			if d < 0 {
+				d = -d
+			}
+			if d > limit {
+				t.Fatalf("jitter %v of tick %d exceeds ±%v", d, i, limit)
+			}
+			if d > largest {
+				largest = d
+			}
+		}
+		if largest >= prev && largest != 0 {
+			t.Errorf("expected jitter of tick %d to be below %v, but got %v", i, prev, largest)
+		}
+		prev = largest
+	}
+
+	// Ticks fire within the decaying jitter and on schedule after the window.
+	for i := 1; i <= 6; i++ {
+		next := dt.NextTick()
+		fc.expectTimer(t, next)
+		fc.Set(next)
+		tick := receive(t, dt.C)
+		if drift, limit := tick.Drift(), dt.decayingJitter(tick.Scheduled); drift < -limit || drift > limit {
+			t.Errorf("tick at %v is outside of ±%v around %v", tick.Actual, limit, tick.Scheduled)
+		}
+		if tick.Scheduled.Sub(first) >= window && tick.Drift() != 0 {
+			t.Errorf("expected tick at %v after the window, but got %v", tick.Scheduled, tick.Actual)
+		}
+	}
+}
+
+func TestJitterFractionOutOfRange(t *testing.T) {
+	for _, f := range []float64{-0.1, 1.1} {
+		func() {
+			defer func() {
+				if err := recover(); err == nil {
+					t.Errorf("WithJitterFraction(%v) should have panicked", f)
+				}
+			}()
+			WithJitterFraction(f)
+		}()
+	}
+}
+
+func TestClockOffset(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	offset := 10 * time.Second
+	fc := newFakeClock(first.Add(30 * time.Second))
+	st := New(first, interval, WithClockOffset(offset), WithClock(fc))
+	defer st.Stop()
+
+	// The local clock is behind by offset, so the boundary is reached earlier in local time.
+	fc.expectTimer(t, first.Add(interval-offset))
+	fc.Advance(20 * time.Second)
+	if tick, want := receive(t, st.C), first.Add(interval); !tick.Equal(want) {
+		t.Errorf("expected tick at corrected time %v, but got %v", want, tick)
+	}
+}
+
+func TestGuaranteedFirst(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	st := New(first, interval, WithGuaranteedFirst(3), WithClock(fc))
+	defer st.Stop()
+
+	// The consumer only starts receiving after the first ticks are due.
+	ticks := make(chan []time.Time)
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		var got []time.Time
+		for i := 0; i < 3; i++ {
+			got = append(got, <-st.C)
+		}
+		ticks <- got
+	}()
+	for i := 0; i < 3; i++ {
+		next := first.Add(time.Duration(i) * interval)
+		fc.expectTimer(t, next)
+		fc.Set(next)
+	}
+	for i, tick := range receive(t, ticks) {
+		if want := first.Add(time.Duration(i) * interval); !tick.Equal(want) {
+			t.Errorf("expected tick %d at %v, but got %v", i+1, want, tick)
+		}
+	}
+
+	// Afterwards ticks are dropped while the consumer is behind.
+	for i := 3; i < 5; i++ {
+		next := first.Add(time.Duration(i) * interval)
+		fc.expectTimer(t, next)
+		fc.Set(next)
+	}
+	fc.expectTimer(t, first.Add(5*interval))
+	if tick, want := receive(t, st.C), first.Add(3*interval); !tick.Equal(want) {
+		t.Errorf("expected tick at %v, but got %v", want, tick)
+	}
+	expectNothing(t, st.C)
+}
+
+func TestLocation(t *testing.T) {
+	loc := time.FixedZone("UTC+3", 3*60*60)
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(first.Add(-time.Second).Local())
+	st := NewDetailed(first, time.Minute, WithLocation(loc), WithClock(fc))
+	defer st.Stop()
+
+	fc.expectTimer(t, first)
+	fc.Set(first)
+	tick := receive(t, st.C)
+	if tick.Actual.Location() != loc || tick.Scheduled.Location() != loc {
+		t.Errorf("expected tick in %v, but got %v and %v", loc, tick.Actual.Location(), tick.Scheduled.Location())
+	}
+	if !tick.Actual.Equal(first) || !tick.Scheduled.Equal(first) {
+		t.Errorf("expected tick at %v, but got %v", first, tick)
+	}
+}
+
+func TestPredicate(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	dt := NewDetailed(first, interval, WithPredicate(func(scheduled time.Time) bool {
+		return scheduled.Minute()%2 == 0
+	}), WithClock(fc))
+	defer dt.Stop()
+
+	var seq uint64
+	for i := 0; i < 6; i++ {
+		next := first.Add(time.Duration(i) * interval)
+		fc.expectTimer(t, next)
+		fc.Set(next)
+		if i%2 != 0 {
+			expectNothing(t, dt.C)
+			continue
+		}
+		seq++
+		tick := receive(t, dt.C)
+		if !tick.Scheduled.Equal(next) || tick.Seq != seq {
+			t.Errorf("expected tick %d at %v, but got %+v", seq, next, tick)
+		}
+	}
+	fc.expectTimer(t, first.Add(6*interval))
+	if s, want := dt.Stats(), (Stats{Delivered: 3, Skipped: 3}); s != want {
+		t.Errorf("expected %+v, but got %+v", want, s)
+	}
+}
+
+func TestGracePeriod(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Second
+
+	for _, tc := range []struct {
+		name string
+		opts []Option
+		want time.Time
+	}{
+		{
+			name: "noGrace",
+			want: first.Add(interval),
+		},
+		{
+			name: "withinGrace",
+			opts: []Option{WithGracePeriod(100 * time.Millisecond)},
+			want: first,
+		},
+		{
+			name: "beyondGrace",
+			opts: []Option{WithGracePeriod(time.Microsecond)},
+			want: first.Add(interval),
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			fc := newFakeClock(first.Add(time.Millisecond))
+			dt := NewDetailed(first, interval, append(tc.opts, WithClock(fc))...)
+			defer dt.Stop()
+
+			if tc.want.After(fc.Now()) {
+				fc.expectTimer(t, tc.want)
+				fc.Set(tc.want)
+			}
+			if tick := receive(t, dt.C); !tick.Scheduled.Equal(tc.want) || tick.Seq != 1 {
+				t.Errorf("expected first tick at %v, but got %+v", tc.want, tick)
+			}
+		})
+	}
+}
+
+func TestCloseOnStop(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	dt := NewDetailed(first, interval, WithCloseOnStop(), WithClock(fc))
+
+	ticks := make(chan []Tick)
+	go func() {
+		var got []Tick
+		for tick := range dt.C {
+			got = append(got, tick)
+		}
+		ticks <- got
+	}()
+	for i := 0; i < 3; i++ {
+		next := first.Add(time.Duration(i) * interval)
+		fc.expectTimer(t, next)
+		fc.Set(next)
+		time.Sleep(10 * time.Millisecond)
+	}
+	dt.Stop()
+	// Ticks fired after Stop are not delivered on the closed channel.
+	dt.Fire()
+
+	got := receive(t, ticks)
+	if len(got) != 3 {
+		t.Errorf("expected 3 ticks before the channel was closed, but got %d", len(got))
+	}
+	for _, tick := range got {
+		if tick == (Tick{}) {
+			t.Error("unexpectedly received zero tick")
+		}
+	}
+	if tick, ok := <-dt.C; ok {
+		t.Errorf("expected closed channel, but received %+v", tick)
+	}
+}
+
+func TestCloseOnStopContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	st := NewWithContext(ctx, time.Now(), time.Millisecond, WithCloseOnStop())
+	cancel()
+	deadline := time.After(time.Second)
+	for {
+		select {
+		case tick, ok := <-st.C:
+			if !ok {
+				return
+			}
+			if tick.IsZero() {
+				t.Fatal("unexpectedly received zero tick")
+			}
+		case <-deadline:
+			t.Fatal("channel not closed")
+		}
+	}
+}
+
+func TestGate(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	gate := make(chan bool)
+	st := New(first, interval, WithGate(gate), WithClock(fc))
+	defer st.Stop()
+
+	for i, open := range []bool{false, true, true, false, true} {
+		if i > 0 {
+			gate <- open
+		}
+		next := first.Add(time.Duration(i) * interval)
+		fc.expectTimer(t, next)
+		fc.Set(next)
+		if !open {
+			expectNothing(t, st.C)
+			continue
+		}
+		if tick := receive(t, st.C); !tick.Equal(next) {
+			t.Errorf("expected tick at %v, but got %v", next, tick)
+		}
+	}
+	close(gate)
+	next := first.Add(5 * interval)
+	fc.expectTimer(t, next)
+	fc.Set(next)
+	expectNothing(t, st.C)
+	if s, want := st.Stats(), (Stats{Delivered: 3, Skipped: 3}); s != want {
+		t.Errorf("expected %+v, but got %+v", want, s)
+	}
+}
+
+func TestBufferSizeAndDropPolicy(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		name   string
+		policy DropPolicy
+		want   []time.Duration
+	}{
+		{name: "newest", policy: DropNewest, want: []time.Duration{0, time.Second, 2 * time.Second}},
+		{name: "oldest", policy: DropOldest, want: []time.Duration{time.Second, 2 * time.Second, 3 * time.Second}},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mt := NewManualTicker(WithBufferSize(3), WithDropPolicy(tc.policy))
+			defer mt.Stop()
+			if n := cap(mt.C); n != 3 {
+				t.Fatalf("expected buffer of 3 ticks, but got %d", n)
+			}
+			for i := 0; i < 4; i++ {
+				mt.FireAt(now.Add(time.Duration(i) * time.Second))
+			}
+			for _, offset := range tc.want {
+				if tick := receive(t, mt.C); !tick.Equal(now.Add(offset)) {
+					t.Errorf("expected tick at %v, but got %v", now.Add(offset), tick)
+				}
+			}
+			expectNothing(t, mt.C)
+		})
+	}
+
+	dt := NewDetailed(now.Add(time.Hour), time.Minute, WithBufferSize(5))
+	defer dt.Stop()
+	if n := cap(dt.C); n != 5 {
+		t.Errorf("expected buffer of 5 ticks, but got %d", n)
+	}
+	st := New(now.Add(time.Hour), time.Minute, WithBufferSize(0))
+	defer st.Stop()
+	if n := cap(st.C); n != 1 {
+		t.Errorf("expected default buffer of 1 tick, but got %d", n)
+	}
+}
+
+func TestBlocking(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	st := New(first, interval, WithBlocking(), WithClock(fc))
+	defer st.Stop()
+
+	fc.expectTimer(t, first)
+	fc.Set(first)
+	fc.expectTimer(t, first.Add(interval))
+	// C is full, so the ticker waits with this tick until the first one is received.
+	fc.Set(first.Add(interval))
+	for _, want := range []time.Time{first, first.Add(interval)} {
+		if tick := receive(t, st.C); !tick.Equal(want) {
+			t.Errorf("expected tick at %v, but got %v", want, tick)
+		}
+	}
+	fc.expectTimer(t, first.Add(2*interval))
+	if stats := st.Stats(); stats.Delivered != 2 || stats.Dropped != 0 {
+		t.Errorf("expected 2 delivered and no dropped ticks, but got %+v", stats)
+	}
+}
@@ -0,0 +1,12 @@
+module github.com/wilriker/sticker/otelsticker
+
+go 1.20
+
+require (
+	github.com/wilriker/sticker v0.0.0
+	go.opentelemetry.io/otel v1.19.0
+	go.opentelemetry.io/otel/trace v1.19.0
+)
+
+// Until a release of sticker includes WithRunInterceptor, build against the sticker module of this repository.
+replace github.com/wilriker/sticker => ../
@@ -0,0 +1,36 @@
+// Package otelsticker integrates sticker tickers with OpenTelemetry tracing.
+//
+// It is a module of its own so that sticker itself does not depend on OpenTelemetry.
+package otelsticker
+
+import (
+	"context"
+	"time"
+
+	"github.com/wilriker/sticker"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SpanName is the name of the span started for every tick.
+const SpanName = "sticker.tick"
+
+// WithTracer makes [sticker.ScheduledTicker.Run] start a span with tracer for every tick it handles.
+// The handler is called with a context carrying the span so that the work done for a tick can be traced
+// end to end. The span ends when the handler returns and records its error if there is one.
+func WithTracer(tracer trace.Tracer) sticker.Option {
+	return sticker.WithRunInterceptor(func(ctx context.Context, t time.Time, handle func(context.Context, time.Time) error) error {
+		ctx, span := tracer.Start(ctx, SpanName,
+			trace.WithSpanKind(trace.SpanKindInternal),
+			trace.WithAttributes(attribute.String("sticker.tick.time", t.Format(time.RFC3339Nano))),
+		)
+		defer span.End()
+		err := handle(ctx, t)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		return err
+	})
+}
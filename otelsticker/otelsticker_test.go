@@ -0,0 +1,47 @@
+package otelsticker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/wilriker/sticker"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// countingTracer is a no-op tracer that records the spans started with it.
+type countingTracer struct {
+	trace.Tracer
+	spans []trace.Span
+}
+
+func (ct *countingTracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	ctx, span := ct.Tracer.Start(ctx, name, opts...)
+	ct.spans = append(ct.spans, span)
+	return ctx, span
+}
+
+func TestWithTracer(t *testing.T) {
+	tracer := &countingTracer{Tracer: trace.NewNoopTracerProvider().Tracer("test")}
+	st := sticker.New(time.Now(), time.Millisecond, WithTracer(tracer))
+
+	errDone := errors.New("done")
+	var handled int
+	err := st.Run(context.Background(), func(ctx context.Context, _ time.Time) error {
+		handled++
+		if span := trace.SpanFromContext(ctx); span != tracer.spans[len(tracer.spans)-1] {
+			t.Error("expected context of the span of the tick")
+		}
+		if handled == 3 {
+			return errDone
+		}
+		return nil
+	})
+	if !errors.Is(err, errDone) {
+		t.Errorf("expected %v, but got %v", errDone, err)
+	}
+	if len(tracer.spans) != handled {
+		t.Errorf("expected %d spans, but got %d", handled, len(tracer.spans))
+	}
+}
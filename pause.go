@@ -0,0 +1,61 @@
+package sticker
+
+// Pause suspends the ticks of the ticker until Resume is called. Unlike Stop the schedule is kept,
+// so the ticks resume on its original phase, and C as well as subscribers stay the same.
+// Ticks of the schedule while paused are skipped; WithResumeCatchUp fires one of them on Resume.
+// Calling Pause on a paused ticker has no effect.
+func (st *ScheduledTicker) Pause() {
+	if st == nil {
+		return
+	}
+	st.setPaused(true)
+}
+
+// Resume continues the ticks of a ticker paused by Pause aligned to its schedule, catching up
+// on a missed tick with WithResumeCatchUp. Calling Resume on a ticker that is not paused has no effect.
+func (st *ScheduledTicker) Resume() {
+	if st == nil {
+		return
+	}
+	st.setPaused(false)
+}
+
+// Paused reports whether the ticker is paused by Pause.
+func (st *ScheduledTicker) Paused() bool {
+	if st == nil {
+		return false
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return st.paused
+}
+
+func (st *ScheduledTicker) setPaused(paused bool) {
+	if st.markPaused(paused) {
+		st.notify()
+	}
+}
+
+// markPaused pauses or resumes st and reports whether that changed anything.
+// It releases st.mu even if the schedule panics.
+func (st *ScheduledTicker) markPaused(paused bool) bool {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if st.paused == paused {
+		return false
+	}
+	st.paused = paused
+	st.reschedule(st.clock.Now())
+	return true
+}
+
+// WithResumeCatchUp makes a paused ticker fire a single tick immediately when it resumes if at least one
+// tick of its schedule was missed while it was paused, e.g. for jobs that must run once per interval.
+// The tick is scheduled for the most recent missed point in time of the schedule, if the schedule can
+// look back, or else for the first missed one. Following ticks are aligned to the schedule as usual.
+// This applies to tickers paused by Pause or a Group as well as to those paused by WithAutoPause.
+func WithResumeCatchUp() Option {
+	return func(st *ScheduledTicker) {
+		st.catchUp = true
+	}
+}
@@ -0,0 +1,96 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPauseResume(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	st := New(first, interval, WithClock(fc))
+	defer st.Stop()
+
+	fc.expectTimer(t, first)
+	fc.Set(first)
+	receive(t, st.C)
+
+	st.Pause()
+	st.Pause()
+	if !st.Paused() {
+		t.Error("expected ticker to be paused")
+	}
+	if next := st.NextTick(); !next.IsZero() {
+		t.Errorf("expected no next tick while paused, but got %v", next)
+	}
+	fc.Set(first.Add(2*interval + 30*time.Second))
+	expectNothing(t, st.C)
+
+	// The ticks resume on the original phase.
+	st.Resume()
+	if st.Paused() {
+		t.Error("expected ticker not to be paused")
+	}
+	want := first.Add(3 * interval)
+	fc.expectTimer(t, want)
+	fc.Set(want)
+	if tick := receive(t, st.C); !tick.Equal(want) {
+		t.Errorf("expected tick at %v, but got %v", want, tick)
+	}
+
+	var nilTicker *ScheduledTicker
+	nilTicker.Pause()
+	nilTicker.Resume()
+	if nilTicker.Paused() {
+		t.Error("expected nil ticker not to be paused")
+	}
+}
+
+func TestResumeCatchUp(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+
+	for _, tc := range []struct {
+		name    string
+		opts    []Option
+		catchUp bool
+	}{
+		{name: "default"},
+		{name: "catchUp", opts: []Option{WithResumeCatchUp()}, catchUp: true},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			fc := newFakeClock(first.Add(-time.Second))
+			dt := NewDetailed(first, interval, append(tc.opts, WithClock(fc))...)
+			defer dt.Stop()
+			var g Group
+			g.Add(dt.ScheduledTicker)
+
+			fc.expectTimer(t, first)
+			fc.Set(first)
+			receive(t, dt.C)
+
+			// A pause within an interval misses nothing.
+			fc.expectTimer(t, first.Add(interval))
+			g.PauseAll()
+			fc.Set(first.Add(interval / 2))
+			g.ResumeAll()
+			fc.expectTimer(t, first.Add(interval))
+			expectNothing(t, dt.C)
+
+			// A pause across two boundaries.
+			g.PauseAll()
+			fc.Set(first.Add(3*interval + time.Second))
+			g.ResumeAll()
+			if tc.catchUp {
+				want := first.Add(3 * interval)
+				if tick := receive(t, dt.C); !tick.Scheduled.Equal(want) {
+					t.Errorf("expected catch-up tick for %v, but got %+v", want, tick)
+				}
+			}
+			fc.expectTimer(t, first.Add(4*interval))
+			expectNothing(t, dt.C)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package sticker
+
+import (
+	"errors"
+	"time"
+)
+
+// MissedTickPolicy defines how a ticker handles ticks whose time has already passed
+// when its schedule is started, e.g. because first lies in the past.
+type MissedTickPolicy int
+
+const (
+	// SkipMissed skips all ticks whose time has passed. The first tick
+	// arrives at the next point in time of the schedule that lies in the future.
+	SkipMissed MissedTickPolicy = iota
+
+	// FireMissed fires a single tick immediately in place of all ticks whose time
+	// has passed. Following ticks arrive according to the schedule.
+	FireMissed
+
+	// BackfillMissed fires one tick for every tick whose time has passed, starting with the first one,
+	// e.g. to process every window of a batch job after a downtime. The missed ticks fire immediately
+	// one after the other, and like with WithGuaranteedFirst the ticker waits for the receiver of C instead
+	// of dropping them. This also applies to ticks missed because the ticker was blocked, e.g. by a slow
+	// receiver. Following ticks arrive according to the schedule.
+	BackfillMissed
+)
+
+// DefaultMissedTickPolicy is the MissedTickPolicy of tickers created without [WithMissedTickPolicy].
+// It is read when a ticker is created, so it should only be changed at program start
+// before any ticker is created.
+var DefaultMissedTickPolicy = SkipMissed
+
+// WithMissedTickPolicy sets the MissedTickPolicy of the ticker,
+// overriding [DefaultMissedTickPolicy].
+func WithMissedTickPolicy(p MissedTickPolicy) Option {
+	return func(st *ScheduledTicker) {
+		st.missed = p
+	}
+}
+
+// ResetWithPolicy is like Reset but handles ticks of the new schedule whose time has already passed
+// according to p instead of the policy of the ticker. With SkipMissed a next in the past results in
+// the first tick at the next point in time of the schedule, with FireMissed it fires immediately and
+// the following ticks are aligned to the schedule. The policy only applies to this call.
+// The duration interval must be greater than zero; if not, ResetWithPolicy will panic.
+func (st *ScheduledTicker) ResetWithPolicy(next time.Time, interval time.Duration, p MissedTickPolicy) {
+	if st == nil {
+		return
+	}
+	if interval <= 0 {
+		panic(errors.New("non-positive interval for ScheduledTicker.ResetWithPolicy"))
+	}
+	st.applyReset(time.Time{}, next, interval, false, p)
+}
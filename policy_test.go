@@ -0,0 +1,97 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMissedTickPolicy(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 30, 0, time.UTC)
+	first := time.Date(2023, 6, 1, 11, 0, 0, 0, time.UTC)
+	interval := time.Minute
+
+	t.Run("skip", func(t *testing.T) {
+		fc := newFakeClock(now)
+		st := New(first, interval, WithClock(fc), WithMissedTickPolicy(SkipMissed))
+		defer st.Stop()
+
+		fc.expectTimer(t, now.Add(30*time.Second))
+		expectNothing(t, st.C)
+	})
+
+	t.Run("fire", func(t *testing.T) {
+		fc := newFakeClock(now)
+		dt := NewDetailed(first, interval, WithClock(fc), WithMissedTickPolicy(FireMissed))
+		defer dt.Stop()
+
+		tick := receive(t, dt.C)
+		if want := now.Add(-30 * time.Second); !tick.Scheduled.Equal(want) {
+			t.Errorf("expected missed tick scheduled at %v, but got %v", want, tick.Scheduled)
+		}
+		if !tick.Actual.Equal(now) {
+			t.Errorf("expected missed tick to fire immediately at %v, but got %v", now, tick.Actual)
+		}
+		fc.expectTimer(t, now.Add(30*time.Second))
+	})
+
+	t.Run("backfill", func(t *testing.T) {
+		fc := newFakeClock(now)
+		first := now.Add(-150 * time.Second)
+		dt := NewDetailed(first, interval, WithClock(fc), WithMissedTickPolicy(BackfillMissed))
+		defer dt.Stop()
+
+		for i := 0; i < 3; i++ {
+			// Let the ticker run ahead to make sure that backfilled ticks are not dropped.
+			time.Sleep(5 * time.Millisecond)
+			tick := receive(t, dt.C)
+			if want := first.Add(time.Duration(i) * interval); !tick.Scheduled.Equal(want) {
+				t.Errorf("expected missed tick scheduled at %v, but got %v", want, tick.Scheduled)
+			}
+			if !tick.Actual.Equal(now) {
+				t.Errorf("expected missed tick to fire immediately at %v, but got %v", now, tick.Actual)
+			}
+		}
+		fc.expectTimer(t, now.Add(30*time.Second))
+		expectNothing(t, dt.C)
+	})
+}
+
+func TestDefaultMissedTickPolicy(t *testing.T) {
+	defer func(p MissedTickPolicy) {
+		DefaultMissedTickPolicy = p
+	}(DefaultMissedTickPolicy)
+	DefaultMissedTickPolicy = FireMissed
+
+	now := time.Date(2023, 6, 1, 12, 0, 30, 0, time.UTC)
+	fc := newFakeClock(now)
+	st := New(now.Add(-time.Hour), time.Minute, WithClock(fc))
+	defer st.Stop()
+
+	if tick := receive(t, st.C); !tick.Equal(now) {
+		t.Errorf("expected missed tick at %v, but got %v", now, tick)
+	}
+}
+
+func TestResetWithPolicy(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 30, 0, time.UTC)
+	past := time.Date(2023, 6, 1, 11, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(now)
+	st := New(now.Add(time.Hour), interval, WithClock(fc))
+	defer st.Stop()
+
+	st.ResetWithPolicy(past, interval, FireMissed)
+	if tick := receive(t, st.C); !tick.Equal(now) {
+		t.Errorf("expected immediate tick at %v, but got %v", now, tick)
+	}
+	fc.expectTimer(t, now.Add(30*time.Second))
+
+	st.ResetWithPolicy(past, interval, SkipMissed)
+	fc.expectTimer(t, now.Add(30*time.Second))
+	expectNothing(t, st.C)
+
+	// The policy of the ticker is unchanged.
+	st.Reset(past, interval)
+	fc.expectTimer(t, now.Add(30*time.Second))
+	expectNothing(t, st.C)
+}
@@ -0,0 +1,198 @@
+package sticker
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Pool runs the schedules of many tickers on a single goroutine and timer instead of a goroutine and
+// a timer per ticker, e.g. for tens of thousands of per-tenant tickers. Tickers of a pool behave like
+// the ones created by New except that options depending on a goroutine of their own, like WithReadySignal,
+// WithGate, WithMaxInitialDelay, WithMinLifetime or WithSupervisor, have no effect. In particular a ticker
+// with WithGate ticks as if the gate was always open, and since there is no goroutine of the ticker to restart,
+// a panic of a ticker, e.g. of its predicate or Schedule, is not recovered and crashes the program. Since the
+// ticks of all tickers are fired one after the other, a ticker waiting for its receiver, e.g. because of
+// WithGuaranteedFirst, delays the ticks of the others.
+// All tickers of a pool share the clock of the pool, but each of them can have its own WithClockOffset.
+// Close the pool to stop all of its tickers and release associated resources.
+type Pool struct {
+	clock Clock
+	ctx   context.Context
+	stop  context.CancelFunc
+	wake  chan struct{}
+
+	mu   sync.Mutex
+	heap poolHeap
+}
+
+// NewPool returns a new Pool that is running until it is closed.
+func NewPool() *Pool {
+	return NewPoolWithClock(realClock{})
+}
+
+// NewPoolWithClock is like NewPool but the pool and all of its tickers use c as their source of time
+// like with WithClock. c must not be nil; if it is, NewPoolWithClock will panic.
+func NewPoolWithClock(c Clock) *Pool {
+	if c == nil {
+		panic(errors.New("nil clock for NewPoolWithClock"))
+	}
+	p := &Pool{
+		clock: c,
+		wake:  make(chan struct{}, 1),
+	}
+	p.ctx, p.stop = context.WithCancel(context.Background())
+	go p.run()
+	return p
+}
+
+// New returns a new ScheduledTicker of the pool like New that starts ticking at time first in the given interval.
+// The ticker is stopped once the pool is closed. The duration interval must be greater than zero and
+// a clock given by WithClock must be the one of the pool; if not, New will panic.
+// Stop the ticker to remove it from the pool.
+func (p *Pool) New(first time.Time, interval time.Duration, opts ...Option) *ScheduledTicker {
+	if interval <= 0 {
+		panic(errors.New("non-positive interval for Pool.New ScheduledTicker"))
+	}
+	ticker := newChanTicker(p.ctx, append([]Option{WithClock(p.clock)}, opts...))
+	clock := ticker.clock
+	if ticker.offset != 0 {
+		clock = clock.(offsetClock).Clock
+	}
+	if clock != p.clock {
+		ticker.Stop()
+		panic(errors.New("clock other than the one of the Pool for Pool.New ScheduledTicker"))
+	}
+	p.join(ticker)
+	ticker.start(first, interval)
+	return ticker
+}
+
+// join makes st a ticker of the pool that is not running yet.
+func (p *Pool) join(st *ScheduledTicker) {
+	st.pool = p
+	// NOTE: there is no goroutine of the ticker to receive from the gate, so it is ignored.
+	st.gate = nil
+}
+
+// Len returns the number of tickers of the pool that have a next tick, i.e. that are neither stopped nor paused.
+func (p *Pool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.heap)
+}
+
+// Close stops all tickers of the pool and its goroutine. Closing a closed pool has no effect.
+func (p *Pool) Close() {
+	p.stop()
+	p.mu.Lock()
+	for _, e := range p.heap {
+		e.st.poolEntry = nil
+	}
+	p.heap = nil
+	p.mu.Unlock()
+}
+
+// update moves st to the position of its next tick in the heap of the pool or
+// removes it if it has none, and wakes up the goroutine of the pool.
+func (p *Pool) update(st *ScheduledTicker) {
+	p.mu.Lock()
+	st.mu.Lock()
+	next, due := st.next, st.fireAt
+	st.mu.Unlock()
+	e := st.poolEntry
+	switch {
+	case next.IsZero() || st.ctx().Err() != nil:
+		if e != nil {
+			heap.Remove(&p.heap, e.index)
+			st.poolEntry = nil
+		}
+	case e == nil:
+		st.poolEntry = &poolEntry{st: st, next: next, due: due.Add(-st.offset)}
+		heap.Push(&p.heap, st.poolEntry)
+	default:
+		e.next, e.due = next, due.Add(-st.offset)
+		heap.Fix(&p.heap, e.index)
+	}
+	p.mu.Unlock()
+
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (p *Pool) run() {
+	timer := p.clock.NewTimer(time.Hour)
+	stopTimer(timer)
+	defer timer.Stop()
+	for {
+		stopTimer(timer)
+		var timerC <-chan time.Time
+		p.mu.Lock()
+		if len(p.heap) > 0 {
+			timer.Reset(p.heap[0].due.Sub(p.clock.Now()))
+			timerC = timer.C()
+		}
+		p.mu.Unlock()
+
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-p.wake:
+		case <-timerC:
+			p.fireDue(p.clock.Now())
+		}
+	}
+}
+
+// fireDue fires the ticks of all tickers that are due at now.
+func (p *Pool) fireDue(now time.Time) {
+	for {
+		p.mu.Lock()
+		if len(p.heap) == 0 || p.heap[0].due.After(now) {
+			p.mu.Unlock()
+			return
+		}
+		e := heap.Pop(&p.heap).(*poolEntry)
+		e.st.poolEntry = nil
+		p.mu.Unlock()
+
+		e.st.tick(now.Add(e.st.offset), e.next)
+		p.update(e.st)
+	}
+}
+
+// poolEntry is a ticker in the heap of a pool together with its next tick.
+type poolEntry struct {
+	st        *ScheduledTicker
+	next, due time.Time // The next tick and when it fires including jitter on the clock of the pool.
+	index     int       // Position in the heap.
+}
+
+// poolHeap is a min-heap of tickers ordered by when their next tick fires.
+type poolHeap []*poolEntry
+
+func (h poolHeap) Len() int           { return len(h) }
+func (h poolHeap) Less(i, j int) bool { return h[i].due.Before(h[j].due) }
+
+func (h poolHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index, h[j].index = i, j
+}
+
+func (h *poolHeap) Push(x any) {
+	e := x.(*poolEntry)
+	e.index = len(*h)
+	*h = append(*h, e)
+}
+
+func (h *poolHeap) Pop() any {
+	old := *h
+	e := old[len(old)-1]
+	old[len(old)-1] = nil
+	*h = old[:len(old)-1]
+	return e
+}
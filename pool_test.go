@@ -0,0 +1,109 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPool(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	p := NewPoolWithClock(fc)
+	defer p.Close()
+
+	a := p.New(first, interval, WithClock(fc))
+	b := p.New(first.Add(interval/2), interval, WithClock(fc))
+	if n := p.Len(); n != 2 {
+		t.Errorf("expected 2 tickers in the pool, but got %d", n)
+	}
+
+	fc.expectTimer(t, first)
+	fc.Set(first)
+	if tick := receive(t, a.C); !tick.Equal(first) {
+		t.Errorf("expected tick at %v, but got %v", first, tick)
+	}
+	expectNothing(t, b.C)
+
+	fc.expectTimer(t, first.Add(interval/2))
+	fc.Set(first.Add(interval / 2))
+	if tick := receive(t, b.C); !tick.Equal(first.Add(interval / 2)) {
+		t.Errorf("expected tick at %v, but got %v", first.Add(interval/2), tick)
+	}
+
+	// A Reset moves the ticker within the pool.
+	a.Reset(first.Add(interval/2+time.Second), interval)
+	fc.expectTimer(t, first.Add(interval/2+time.Second))
+	fc.Set(first.Add(interval/2 + time.Second))
+	receive(t, a.C)
+
+	b.Stop()
+	if n := p.Len(); n != 1 {
+		t.Errorf("expected 1 ticker in the pool after Stop, but got %d", n)
+	}
+	a.Pause()
+	if n := p.Len(); n != 0 {
+		t.Errorf("expected no ticker in the pool while paused, but got %d", n)
+	}
+	fc.expectNoTimer(t)
+	a.Resume()
+	fc.expectTimer(t, first.Add(3*interval/2+time.Second))
+
+	p.Close()
+	if !a.Stopped() {
+		t.Error("expected ticker to be stopped by Close")
+	}
+	if n := p.Len(); n != 0 {
+		t.Errorf("expected no ticker in a closed pool, but got %d", n)
+	}
+}
+
+func BenchmarkPool(b *testing.B) {
+	p := NewPool()
+	defer p.Close()
+	now := time.Now()
+	for i := 0; i < b.N; i++ {
+		p.New(now.Add(time.Hour), time.Hour)
+	}
+}
+
+func TestPoolGate(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(first.Add(-time.Second))
+	p := NewPoolWithClock(fc)
+	defer p.Close()
+
+	gate := make(chan bool)
+	st := p.New(first, time.Minute, WithGate(gate))
+	for i := 0; i < 3; i++ {
+		next := first.Add(time.Duration(i) * time.Minute)
+		fc.expectTimer(t, next)
+		fc.Set(next)
+		receive(t, st.C)
+	}
+	if stats := st.Stats(); stats.Delivered != 3 || stats.Skipped != 0 {
+		t.Errorf("expected the gate to be ignored, but got %+v", stats)
+	}
+}
+
+func TestPoolClockOffset(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(first.Add(-time.Minute))
+	p := NewPoolWithClock(fc)
+	defer p.Close()
+
+	// The ticker is a second ahead of the clock of the pool.
+	st := p.New(first, time.Minute, WithClockOffset(time.Second))
+	fc.expectTimer(t, first.Add(-time.Second))
+	fc.Set(first.Add(-time.Second))
+	if tick := receive(t, st.C); !tick.Equal(first) {
+		t.Errorf("expected tick at %v, but got %v", first, tick)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic for a clock other than the one of the pool")
+		}
+	}()
+	p.New(first, time.Minute, WithClock(newFakeClock(first)))
+}
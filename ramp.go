@@ -0,0 +1,54 @@
+package sticker
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// NewRamp returns a new ScheduledTicker starting at first whose interval changes linearly from start to end
+// over the duration over, e.g. to gradually increase polling pressure after startup. Each interval is determined
+// by the time elapsed since first at the tick it begins with. Once over has elapsed the ticker continues
+// with a constant interval of end, aligned to the first tick after the ramp. A zero first means now.
+// The durations start and end must be greater than zero and over must not be negative; if not, NewRamp will panic.
+// Stop the ticker to release associated resources.
+func NewRamp(first time.Time, start, end, over time.Duration, opts ...Option) *ScheduledTicker {
+	if start <= 0 || end <= 0 {
+		panic(errors.New("non-positive interval for NewRamp ScheduledTicker"))
+	}
+	if over < 0 {
+		panic(errors.New("negative ramp duration for NewRamp ScheduledTicker"))
+	}
+	ticker := newChanTicker(context.Background(), opts)
+	if first.IsZero() {
+		first = ticker.clock.Now()
+	}
+	ticker.startSchedule(ramp{first: first, start: start, end: end, over: over}, end)
+	return ticker
+}
+
+// ramp is the schedule of an interval changing linearly from start to end over the duration over.
+type ramp struct {
+	first            time.Time
+	start, end, over time.Duration
+}
+
+func (r ramp) next(t time.Time) time.Time {
+	p := r.first
+	for !p.After(t) {
+		if p.Sub(r.first) >= r.over {
+			return NextRun(p, r.end, t)
+		}
+		p = p.Add(r.interval(p))
+	}
+	return p
+}
+
+// interval returns the interval beginning with the tick at p.
+func (r ramp) interval(p time.Time) time.Duration {
+	elapsed := p.Sub(r.first)
+	if elapsed >= r.over {
+		return r.end
+	}
+	return r.start + time.Duration(float64(r.end-r.start)*float64(elapsed)/float64(r.over))
+}
@@ -0,0 +1,72 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRamp(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		name       string
+		start, end time.Duration
+	}{
+		{name: "increasing", start: time.Second, end: 10 * time.Second},
+		{name: "decreasing", start: 10 * time.Second, end: time.Second},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			over := time.Minute
+			fc := newFakeClock(first.Add(-time.Second))
+			st := NewRamp(first, tc.start, tc.end, over, WithClock(fc))
+			defer st.Stop()
+
+			var ticks []time.Time
+			for next := first; next.Before(first.Add(over + 3*tc.end)); {
+				fc.expectTimer(t, next)
+				fc.Set(next)
+				ticks = append(ticks, receive(t, st.C))
+				next = st.NextTick()
+			}
+
+			if !ticks[0].Equal(first) {
+				t.Errorf("expected first tick at %v, but got %v", first, ticks[0])
+			}
+			if d := ticks[1].Sub(ticks[0]); d != tc.start {
+				t.Errorf("expected first interval %v, but got %v", tc.start, d)
+			}
+			prev := tc.start
+			for i := 2; i < len(ticks); i++ {
+				d := ticks[i].Sub(ticks[i-1])
+				if (tc.end > tc.start && d < prev) || (tc.end < tc.start && d > prev) {
+					t.Errorf("expected interval %d to move from %v toward %v, but got %v", i, prev, tc.end, d)
+				}
+				if ticks[i-1].Sub(first) >= over && d != tc.end {
+					t.Errorf("expected interval %v after the ramp, but got %v", tc.end, d)
+				}
+				prev = d
+			}
+		})
+	}
+}
+
+func TestRampNext(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	r := ramp{first: first, start: time.Second, end: 3 * time.Second, over: 4 * time.Second}
+
+	// The ticks of the ramp are at 0s, 1s, 2.5s, 4.75s and then every 3s.
+	for _, tc := range []struct {
+		t, want time.Duration
+	}{
+		{t: -time.Second, want: 0},
+		{t: 0, want: time.Second},
+		{t: 2 * time.Second, want: 2500 * time.Millisecond},
+		{t: 3 * time.Second, want: 4750 * time.Millisecond},
+		{t: 5 * time.Second, want: 7750 * time.Millisecond},
+		{t: 7750 * time.Millisecond, want: 10750 * time.Millisecond},
+	} {
+		if next := r.next(first.Add(tc.t)); !next.Equal(first.Add(tc.want)) {
+			t.Errorf("expected next after %v at %v, but got %v", tc.t, tc.want, next.Sub(first))
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package sticker
+
+// ReconfigCount returns how often the schedule of the ticker was changed by Reset or one of its variants
+// since the ticker was created. A count that grows quickly hints at a flapping source of configuration.
+// Resets that did not take effect because of a concurrent ResetPriority are not counted.
+func (st *ScheduledTicker) ReconfigCount() uint64 {
+	if st == nil {
+		return 0
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return st.reconfig
+}
+
+// WithOnReconfig calls f with the old and the new schedule whenever the schedule is changed by Reset or
+// one of its variants, e.g. to count reconfigurations in a metric. f is called after the change took
+// effect from the goroutine calling Reset and must not block for long.
+func WithOnReconfig(f func(old, new Config)) Option {
+	return func(st *ScheduledTicker) {
+		st.onReconfig = f
+	}
+}
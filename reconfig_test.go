@@ -0,0 +1,35 @@
+package sticker
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestReconfig(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(first.Add(-time.Second))
+	var changes [][2]Config
+	st := New(first, time.Minute, WithOnReconfig(func(old, new Config) {
+		changes = append(changes, [2]Config{old, new})
+	}), WithClock(fc))
+	defer st.Stop()
+
+	if n := st.ReconfigCount(); n != 0 {
+		t.Errorf("expected no reconfiguration after creation, but got %d", n)
+	}
+	st.Reset(first, 2*time.Minute)
+	st.ResetWithPolicy(first.Add(time.Hour), time.Hour, SkipMissed)
+	st.ResetPriority(first, time.Minute)
+	if n := st.ReconfigCount(); n != 3 {
+		t.Errorf("expected 3 reconfigurations, but got %d", n)
+	}
+	want := [][2]Config{
+		{{first, time.Minute}, {first, 2 * time.Minute}},
+		{{first, 2 * time.Minute}, {first.Add(time.Hour), time.Hour}},
+		{{first.Add(time.Hour), time.Hour}, {first, time.Minute}},
+	}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("expected changes %v, but got %v", want, changes)
+	}
+}
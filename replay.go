@@ -0,0 +1,88 @@
+package sticker
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// ReplayClock is a simulation clock for the deterministic replay of recorded timelines. Tickers created
+// by it do not follow the real time but only advance when the clock is advanced by Advance, which fires
+// exactly the ticks that would have fired in between. Create it with NewReplayClock.
+type ReplayClock struct {
+	advance sync.Mutex // Serializes Advance and NewTicker.
+	tickers []*ScheduledTicker
+
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewReplayClock returns a new ReplayClock whose current time is start.
+func NewReplayClock(start time.Time) *ReplayClock {
+	return &ReplayClock{now: start}
+}
+
+// Now returns the current time of the clock.
+func (c *ReplayClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// NewTicker returns a new ScheduledTicker like NewManual that is driven by c. Its ticks are never
+// dropped on C: delivering a tick waits until the previous one was received. Subscribers may still
+// miss ticks if they fall behind. A zero first means the current time of c.
+// The duration interval must be greater than zero; if not, NewTicker will panic.
+func (c *ReplayClock) NewTicker(first time.Time, interval time.Duration, opts ...Option) *ScheduledTicker {
+	c.advance.Lock()
+	defer c.advance.Unlock()
+	now := c.Now()
+	if first.IsZero() {
+		first = now
+	}
+	ticker := NewManual(first, interval, opts...)
+	ticker.guaranteed = math.MaxUint64
+	ticker.Tick(now)
+	c.tickers = append(c.tickers, ticker)
+	return ticker
+}
+
+// Advance moves the clock forward to to and fires all ticks of its tickers that are due until then
+// in chronological order, ticks at the same point in time in the order the tickers were created.
+// It returns once all of them are delivered, which requires the ticks on C to be received concurrently.
+// While a tick is delivered Now reports the point in time it is due at.
+// Advance does nothing if to is before the current time of the clock.
+func (c *ReplayClock) Advance(to time.Time) {
+	c.advance.Lock()
+	defer c.advance.Unlock()
+	if to.Before(c.Now()) {
+		return
+	}
+	for {
+		var due *ScheduledTicker
+		var at time.Time
+		for _, st := range c.tickers {
+			if st.Stopped() {
+				continue
+			}
+			if next := st.NextTick(); !next.IsZero() && !next.After(to) && (due == nil || next.Before(at)) {
+				due, at = st, next
+			}
+		}
+		if due == nil {
+			break
+		}
+		c.set(at)
+		due.Tick(at)
+	}
+	c.set(to)
+	for _, st := range c.tickers {
+		st.Tick(to)
+	}
+}
+
+func (c *ReplayClock) set(now time.Time) {
+	c.mu.Lock()
+	c.now = now
+	c.mu.Unlock()
+}
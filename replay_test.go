@@ -0,0 +1,56 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReplayClock(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewReplayClock(start)
+	minutely := c.NewTicker(start.Add(time.Minute), time.Minute)
+	defer minutely.Stop()
+	quarterly := c.NewTicker(start.Add(15*time.Minute), 15*time.Minute)
+	defer quarterly.Stop()
+
+	record := func(st *ScheduledTicker) <-chan []time.Time {
+		done := make(chan []time.Time)
+		go func() {
+			var ticks []time.Time
+			for tick := range st.C {
+				ticks = append(ticks, tick)
+				if !tick.Before(start.Add(time.Hour)) {
+					break
+				}
+			}
+			done <- ticks
+		}()
+		return done
+	}
+	minutes, quarters := record(minutely), record(quarterly)
+
+	// Replay the timeline in uneven steps.
+	for _, step := range []time.Duration{90 * time.Second, 10 * time.Minute, 30 * time.Minute, time.Hour} {
+		c.Advance(start.Add(step))
+		if now := c.Now(); !now.Equal(start.Add(step)) {
+			t.Errorf("expected clock at %v, but got %v", start.Add(step), now)
+		}
+	}
+
+	for _, tc := range []struct {
+		ticks    []time.Time
+		interval time.Duration
+	}{
+		{receive(t, minutes), time.Minute},
+		{receive(t, quarters), 15 * time.Minute},
+	} {
+		if want := int(time.Hour / tc.interval); len(tc.ticks) != want {
+			t.Errorf("expected %d ticks every %v, but got %d", want, tc.interval, len(tc.ticks))
+		}
+		for i, tick := range tc.ticks {
+			if want := start.Add(time.Duration(i+1) * tc.interval); !tick.Equal(want) {
+				t.Errorf("expected tick %d at %v, but got %v", i, want, tick)
+			}
+		}
+	}
+}
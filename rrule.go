@@ -0,0 +1,308 @@
+package sticker
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RRule parses the recurrence rule rule of RFC 5545, e.g. "FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=9;COUNT=10",
+// and returns it as a Schedule whose first point in time is at or after start, which is DTSTART of the rule.
+// The points in time are in the location of start and have its seconds. An optional "RRULE:" prefix is ignored.
+// The supported rule parts are FREQ from MINUTELY to YEARLY, INTERVAL, COUNT, UNTIL, BYMONTH, BYMONTHDAY,
+// BYDAY without ordinals, BYHOUR, BYMINUTE and WKST=MO. Rule parts that are not given default to start like
+// RFC 5545 defines, e.g. "FREQ=WEEKLY" is on the weekday and at the time of day of start.
+// An error is returned if rule is not a valid rule or uses unsupported rule parts.
+func RRule(rule string, start time.Time) (Schedule, error) {
+	r, err := parseRRule(rule, start)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// NewRRule returns a new ScheduledTicker that ticks according to the recurrence rule rule starting at start
+// as described by RRule. The ticker is stopped once the rule has no more points in time, e.g. after COUNT ticks.
+// An error is returned if rule is not a valid rule or uses unsupported rule parts.
+// Stop the ticker to release associated resources.
+func NewRRule(rule string, start time.Time, opts ...Option) (*ScheduledTicker, error) {
+	r, err := parseRRule(rule, start)
+	if err != nil {
+		return nil, err
+	}
+	return NewFromSchedule(r, opts...), nil
+}
+
+// rruleFreq is the FREQ of a recurrence rule.
+type rruleFreq int
+
+const (
+	freqMinutely rruleFreq = iota
+	freqHourly
+	freqDaily
+	freqWeekly
+	freqMonthly
+	freqYearly
+)
+
+var rruleFreqs = map[string]rruleFreq{
+	"MINUTELY": freqMinutely,
+	"HOURLY":   freqHourly,
+	"DAILY":    freqDaily,
+	"WEEKLY":   freqWeekly,
+	"MONTHLY":  freqMonthly,
+	"YEARLY":   freqYearly,
+}
+
+// maxPeriod is the longest a period of each frequency can last, including a daylight saving time change.
+var maxPeriod = [...]time.Duration{
+	freqMinutely: time.Minute,
+	freqHourly:   time.Hour,
+	freqDaily:    25 * time.Hour,
+	freqWeekly:   7 * 25 * time.Hour,
+	freqMonthly:  31 * 25 * time.Hour,
+	freqYearly:   366 * 25 * time.Hour,
+}
+
+// rruleDays are the weekdays of BYDAY in the order of time.Weekday.
+var rruleDays = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// rrule is the schedule of a recurrence rule. Empty sets of the BY rule parts match every value.
+type rrule struct {
+	freq     rruleFreq
+	interval int
+	count    int       // Number of points in time, 0 if unlimited.
+	until    time.Time // The last possible point in time, zero if unlimited.
+	start    time.Time
+
+	months, monthDays, weekdays, hours, minutes bits
+}
+
+func parseRRule(rule string, start time.Time) (*rrule, error) {
+	r := &rrule{freq: -1, interval: 1, start: start}
+	for _, part := range strings.Split(strings.TrimPrefix(rule, "RRULE:"), ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid rrule %q: invalid rule part %q", rule, part)
+		}
+		var err error
+		switch strings.ToUpper(name) {
+		case "FREQ":
+			freq, ok := rruleFreqs[strings.ToUpper(value)]
+			if !ok {
+				return nil, fmt.Errorf("invalid rrule %q: unsupported FREQ %q", rule, value)
+			}
+			r.freq = freq
+		case "INTERVAL":
+			r.interval, err = rrulePositive(name, value)
+		case "COUNT":
+			r.count, err = rrulePositive(name, value)
+		case "UNTIL":
+			r.until, err = parseRRuleUntil(value, start.Location())
+		case "BYMONTH":
+			r.months, err = rruleNumbers(name, value, 1, 12)
+		case "BYMONTHDAY":
+			r.monthDays, err = rruleNumbers(name, value, 1, 31)
+		case "BYHOUR":
+			r.hours, err = rruleNumbers(name, value, 0, 23)
+		case "BYMINUTE":
+			r.minutes, err = rruleNumbers(name, value, 0, 59)
+		case "BYDAY":
+			r.weekdays, err = rruleWeekdays(value)
+		case "WKST":
+			if !strings.EqualFold(value, "MO") {
+				err = fmt.Errorf("unsupported WKST %q", value)
+			}
+		default:
+			err = fmt.Errorf("unsupported rule part %q", name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid rrule %q: %w", rule, err)
+		}
+	}
+	if r.freq < 0 {
+		return nil, fmt.Errorf("invalid rrule %q: missing FREQ", rule)
+	}
+	if r.count > 0 && !r.until.IsZero() {
+		return nil, fmt.Errorf("invalid rrule %q: both COUNT and UNTIL", rule)
+	}
+
+	// Expand the parts that are not given from start.
+	if r.freq >= freqDaily && r.hours == 0 {
+		r.hours = 1 << uint(start.Hour())
+	}
+	if r.freq >= freqHourly && r.minutes == 0 {
+		r.minutes = 1 << uint(start.Minute())
+	}
+	switch {
+	case r.freq == freqWeekly && r.weekdays == 0:
+		r.weekdays = 1 << uint(start.Weekday())
+	case r.freq == freqMonthly && r.weekdays == 0 && r.monthDays == 0:
+		r.monthDays = 1 << uint(start.Day())
+	case r.freq == freqYearly && r.weekdays == 0 && r.monthDays == 0:
+		r.monthDays = 1 << uint(start.Day())
+		if r.months == 0 {
+			r.months = 1 << uint(start.Month())
+		}
+	}
+	return r, nil
+}
+
+// rrulePositive parses the positive number value of the rule part name.
+func rrulePositive(name, value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid %s %q", name, value)
+	}
+	return n, nil
+}
+
+// rruleNumbers parses the comma-separated list of numbers within [min, max] value of the rule part name.
+func rruleNumbers(name, value string, min, max int) (bits, error) {
+	var set bits
+	for _, s := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < min || n > max {
+			return 0, fmt.Errorf("invalid %s %q", name, s)
+		}
+		set |= 1 << uint(n)
+	}
+	return set, nil
+}
+
+// rruleWeekdays parses the comma-separated list of weekdays value of BYDAY.
+func rruleWeekdays(value string) (bits, error) {
+	var set bits
+	for _, s := range strings.Split(value, ",") {
+		i := 0
+		for i < len(rruleDays) && !strings.EqualFold(s, rruleDays[i]) {
+			i++
+		}
+		if i == len(rruleDays) {
+			return 0, fmt.Errorf("unsupported BYDAY %q", s)
+		}
+		set |= 1 << uint(i)
+	}
+	return set, nil
+}
+
+// parseRRuleUntil parses the date or date-time value of UNTIL. Date-times without a trailing "Z" and
+// dates are in loc, and dates include the whole day.
+func parseRRuleUntil(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("20060102T150405", value, loc); err == nil {
+		return t, nil
+	}
+	t, err := time.ParseInLocation("20060102", value, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid UNTIL %q", value)
+	}
+	return t.AddDate(0, 0, 1).Add(-time.Nanosecond), nil
+}
+
+// Next implements Schedule.
+func (r *rrule) Next(after time.Time) time.Time {
+	return r.next(after)
+}
+
+func (r *rrule) next(t time.Time) time.Time {
+	k := 0
+	// COUNT counts from start so that only rules without it can skip the periods before t.
+	if r.count == 0 && t.After(r.start) {
+		k = int(float64(t.Sub(r.start))/float64(maxPeriod[r.freq])/float64(r.interval)) - 1
+		if k < 0 {
+			k = 0
+		}
+	}
+	limit := t.AddDate(cronSearchLimit, 0, 0)
+	n := 0
+	for prev := (time.Time{}); ; k++ {
+		from, to := r.period(k)
+		// Give up once a whole period after t had no point in time and the search limit is reached.
+		if !r.until.IsZero() && from.After(r.until) || from.After(limit) && prev.After(t) {
+			return time.Time{}
+		}
+		for _, p := range r.within(from, to) {
+			n++
+			if r.count > 0 && n > r.count || !r.until.IsZero() && p.After(r.until) {
+				return time.Time{}
+			}
+			if p.After(t) {
+				return p
+			}
+		}
+		prev = from
+	}
+}
+
+// period returns the start and end of the kth period of the rule after the one of start.
+func (r *rrule) period(k int) (from, to time.Time) {
+	s, loc := r.start, r.start.Location()
+	y, m, d := s.Date()
+	n := k * r.interval
+	switch r.freq {
+	case freqMinutely:
+		from = time.Date(y, m, d, s.Hour(), s.Minute(), 0, 0, loc).Add(time.Duration(n) * time.Minute)
+		return from, from.Add(time.Minute)
+	case freqHourly:
+		from = time.Date(y, m, d, s.Hour(), 0, 0, 0, loc).Add(time.Duration(n) * time.Hour)
+		return from, from.Add(time.Hour)
+	case freqDaily:
+		return time.Date(y, m, d+n, 0, 0, 0, 0, loc), time.Date(y, m, d+n+1, 0, 0, 0, 0, loc)
+	case freqWeekly:
+		// Weeks start on Monday.
+		d -= (int(s.Weekday()) + 6) % 7
+		return time.Date(y, m, d+7*n, 0, 0, 0, 0, loc), time.Date(y, m, d+7*n+7, 0, 0, 0, 0, loc)
+	case freqMonthly:
+		return time.Date(y, m+time.Month(n), 1, 0, 0, 0, 0, loc), time.Date(y, m+time.Month(n)+1, 1, 0, 0, 0, 0, loc)
+	default:
+		return time.Date(y+n, 1, 1, 0, 0, 0, 0, loc), time.Date(y+n+1, 1, 1, 0, 0, 0, 0, loc)
+	}
+}
+
+// within returns the points in time of the rule within [from, to) in chronological order.
+func (r *rrule) within(from, to time.Time) []time.Time {
+	var points []time.Time
+	loc := r.start.Location()
+	y, m, d := from.Date()
+	for day := time.Date(y, m, d, 0, 0, 0, 0, loc); day.Before(to); day = time.Date(y, m, d, 0, 0, 0, 0, loc) {
+		if matches(r.months, int(m)) && matches(r.monthDays, d) && matches(r.weekdays, int(day.Weekday())) {
+			for _, h := range r.values(r.hours, 23, freqHourly, from.Hour()) {
+				for _, minute := range r.values(r.minutes, 59, freqMinutely, from.Minute()) {
+					p := time.Date(y, m, d, h, minute, r.start.Second(), r.start.Nanosecond(), loc)
+					if !p.Before(from) && p.Before(to) && !p.Before(r.start) {
+						points = append(points, p)
+					}
+				}
+			}
+		}
+		y, m, d = day.AddDate(0, 0, 1).Date()
+	}
+	return points
+}
+
+// values returns the values of set up to max in ascending order. If the rule is not more frequent than
+// freq, the period is shorter than the unit of set so that only the value of the period itself is returned.
+func (r *rrule) values(set bits, max int, freq rruleFreq, current int) []int {
+	if r.freq <= freq {
+		if !matches(set, current) {
+			return nil
+		}
+		return []int{current}
+	}
+	var values []int
+	for i := 0; i <= max; i++ {
+		if set.has(i) {
+			values = append(values, i)
+		}
+	}
+	return values
+}
+
+// matches reports whether the value v is in set, where the empty set matches every value.
+func matches(set bits, v int) bool {
+	return set == 0 || set.has(v)
+}
@@ -0,0 +1,160 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRRuleNext(t *testing.T) {
+	start := time.Date(2023, 6, 1, 9, 30, 0, 0, time.UTC) // A Thursday.
+	cases := []struct {
+		rule string
+		want []time.Time
+	}{
+		{
+			rule: "FREQ=DAILY;COUNT=3",
+			want: []time.Time{
+				time.Date(2023, 6, 1, 9, 30, 0, 0, time.UTC),
+				time.Date(2023, 6, 2, 9, 30, 0, 0, time.UTC),
+				time.Date(2023, 6, 3, 9, 30, 0, 0, time.UTC),
+				{},
+			},
+		},
+		{
+			rule: "RRULE:FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=9,17;BYMINUTE=0",
+			want: []time.Time{
+				time.Date(2023, 6, 5, 9, 0, 0, 0, time.UTC),
+				time.Date(2023, 6, 5, 17, 0, 0, 0, time.UTC),
+				time.Date(2023, 6, 7, 9, 0, 0, 0, time.UTC),
+				time.Date(2023, 6, 7, 17, 0, 0, 0, time.UTC),
+				time.Date(2023, 6, 12, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			rule: "FREQ=HOURLY;INTERVAL=2;BYDAY=FR;UNTIL=20230602T050000Z",
+			want: []time.Time{
+				time.Date(2023, 6, 2, 1, 30, 0, 0, time.UTC),
+				time.Date(2023, 6, 2, 3, 30, 0, 0, time.UTC),
+				{},
+			},
+		},
+		{
+			rule: "FREQ=DAILY;UNTIL=20230602",
+			want: []time.Time{
+				time.Date(2023, 6, 1, 9, 30, 0, 0, time.UTC),
+				time.Date(2023, 6, 2, 9, 30, 0, 0, time.UTC),
+				{},
+			},
+		},
+		{
+			rule: "FREQ=MINUTELY;INTERVAL=15;BYHOUR=10",
+			want: []time.Time{
+				time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC),
+				time.Date(2023, 6, 1, 10, 15, 0, 0, time.UTC),
+				time.Date(2023, 6, 1, 10, 30, 0, 0, time.UTC),
+				time.Date(2023, 6, 1, 10, 45, 0, 0, time.UTC),
+				time.Date(2023, 6, 2, 10, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			rule: "FREQ=MONTHLY;BYMONTHDAY=31",
+			want: []time.Time{
+				time.Date(2023, 7, 31, 9, 30, 0, 0, time.UTC),
+				time.Date(2023, 8, 31, 9, 30, 0, 0, time.UTC),
+				time.Date(2023, 10, 31, 9, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			// The first Saturday of every other month.
+			rule: "FREQ=MONTHLY;INTERVAL=2;BYDAY=SA;BYMONTHDAY=1,2,3,4,5,6,7",
+			want: []time.Time{
+				time.Date(2023, 6, 3, 9, 30, 0, 0, time.UTC),
+				time.Date(2023, 8, 5, 9, 30, 0, 0, time.UTC),
+				time.Date(2023, 10, 7, 9, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			rule: "freq=yearly",
+			want: []time.Time{
+				time.Date(2023, 6, 1, 9, 30, 0, 0, time.UTC),
+				time.Date(2024, 6, 1, 9, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			rule: "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30",
+			want: []time.Time{{}},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.rule, func(t *testing.T) {
+			r, err := parseRRule(tc.rule, start)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			next := start.Add(-time.Second)
+			for _, want := range tc.want {
+				next = r.next(next)
+				if !next.Equal(want) {
+					t.Fatalf("expected %v, but got %v", want, next)
+				}
+			}
+		})
+	}
+}
+
+func TestRRuleNextFarAfterStart(t *testing.T) {
+	start := time.Date(2023, 6, 1, 9, 30, 0, 0, time.UTC)
+	r, err := parseRRule("FREQ=WEEKLY;INTERVAL=2", start)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 2033-06-02 is 522 weeks after start.
+	now := time.Date(2033, 6, 1, 0, 0, 0, 0, time.UTC)
+	if next, want := r.next(now), time.Date(2033, 6, 2, 9, 30, 0, 0, time.UTC); !next.Equal(want) {
+		t.Errorf("expected %v, but got %v", want, next)
+	}
+}
+
+func TestRRuleInvalid(t *testing.T) {
+	for _, rule := range []string{
+		"",
+		"COUNT=3",
+		"FREQ=SECONDLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=-1",
+		"FREQ=DAILY;COUNT=3;UNTIL=20230602",
+		"FREQ=DAILY;UNTIL=tomorrow",
+		"FREQ=DAILY;BYHOUR=24",
+		"FREQ=DAILY;BYMONTH=0",
+		"FREQ=MONTHLY;BYDAY=1MO",
+		"FREQ=MONTHLY;BYSETPOS=-1",
+		"FREQ=WEEKLY;WKST=SU",
+		"FREQ=DAILY;",
+	} {
+		if _, err := RRule(rule, time.Now()); err == nil {
+			t.Errorf("expected error for %q", rule)
+		}
+	}
+}
+
+func TestNewRRule(t *testing.T) {
+	now := time.Date(2023, 6, 1, 9, 0, 0, 0, time.UTC)
+	st, err := NewRRule("FREQ=DAILY;COUNT=2", now.Add(time.Hour), WithClock(newFakeClock(now)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer st.Stop()
+	if next, want := st.NextTick(), now.Add(time.Hour); !next.Equal(want) {
+		t.Errorf("expected first tick at %v, but got %v", want, next)
+	}
+
+	st, err = NewRRule("FREQ=DAILY;UNTIL=20230531", now, WithClock(newFakeClock(now)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !st.Stopped() {
+		t.Error("expected ticker of a rule without future points in time to be stopped")
+	}
+}
@@ -0,0 +1,126 @@
+package sticker
+
+import (
+	"context"
+	"time"
+)
+
+// Run calls handle for every tick of the ticker until ctx is done, the ticker is stopped or handle returns
+// an error. The ticker is stopped when Run returns. Run returns the error of handle or nil otherwise, which
+// makes it suitable to be run by e.g. errgroup.Group.Go. handle is called with ctx and the time of the tick.
+// Ticks that happen while handle is running are dropped like on C, unless WithConcurrency allows further
+// calls of handle to run at the same time. The ticks are received via a subscriber so C is not affected.
+func (st *ScheduledTicker) Run(ctx context.Context, handle func(context.Context, time.Time) error) error {
+	if st == nil {
+		return nil
+	}
+	defer st.Stop()
+	sub := st.Subscribe()
+	defer st.Unsubscribe(sub)
+	if st.concurrency <= 1 {
+		return st.consume(ctx, sub, handle)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	errs := make(chan error, st.concurrency)
+	for i := 0; i < st.concurrency; i++ {
+		go func() {
+			err := st.consume(ctx, sub, handle)
+			if err != nil {
+				// Make the other workers return as well.
+				cancel()
+			}
+			errs <- err
+		}()
+	}
+	var err error
+	for i := 0; i < st.concurrency; i++ {
+		if e := <-errs; e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// WithConcurrency makes Run call its handler for up to n ticks at the same time, so that a slow handler
+// does not delay the handling of the following ticks. At most n calls are in flight and at most one
+// further tick is pending, or as many as set by WithBufferSize; further ticks that happen meanwhile are dropped.
+// Once a call returns an error the context of the other calls is cancelled and Run returns the error
+// after all of them returned. A value of n below 2 handles one tick at a time, which is the default.
+func WithConcurrency(n int) Option {
+	return func(st *ScheduledTicker) {
+		st.concurrency = n
+	}
+}
+
+// consume calls handle for every tick received from sub until ctx is done, the ticker is stopped
+// or handle returns an error.
+func (st *ScheduledTicker) consume(ctx context.Context, sub <-chan time.Time, handle func(context.Context, time.Time) error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-st.ctx().Done():
+			return nil
+		case t := <-sub:
+			if err := st.handleWithTimeout(ctx, t, handle); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// RunInterceptor is called by Run for every tick in place of its handler. It must call handle with
+// ctx, which it may derive a new context from, and t and should return the error of handle.
+type RunInterceptor func(ctx context.Context, t time.Time, handle func(context.Context, time.Time) error) error
+
+// WithRunInterceptor makes Run call the handler of each tick through f, e.g. to trace or log the
+// handling of ticks. Packages integrating with instrumentation libraries build their options on it.
+func WithRunInterceptor(f RunInterceptor) Option {
+	return func(st *ScheduledTicker) {
+		st.intercept = f
+	}
+}
+
+// handle calls handle for the tick at t through the interceptor if there is one.
+func (st *ScheduledTicker) handle(ctx context.Context, t time.Time, handle func(context.Context, time.Time) error) error {
+	if st.intercept != nil {
+		return st.intercept(ctx, t, handle)
+	}
+	return handle(ctx, t)
+}
+
+// WithTickTimeout limits how long Run waits for the handler of a tick to d. If the handler takes longer
+// its context is cancelled, which is counted in [Stats.TimedOut], and Run continues with the next tick
+// without waiting for the handler to return. The error of a handler that timed out is ignored.
+// A non-positive d disables the timeout.
+func WithTickTimeout(d time.Duration) Option {
+	return func(st *ScheduledTicker) {
+		st.tickTimeout = d
+	}
+}
+
+// handleWithTimeout calls handle for the tick at t and gives up once the tick timeout expired.
+func (st *ScheduledTicker) handleWithTimeout(ctx context.Context, t time.Time, handle func(context.Context, time.Time) error) error {
+	if st.tickTimeout <= 0 {
+		return st.handle(ctx, t, handle)
+	}
+	tickCtx, cancel := context.WithTimeout(ctx, st.tickTimeout)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- st.handle(tickCtx, t, handle)
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-tickCtx.Done():
+		if ctx.Err() == nil {
+			st.mu.Lock()
+			st.stats.TimedOut++
+			st.mu.Unlock()
+		}
+		return nil
+	}
+}
@@ -0,0 +1,199 @@
+package sticker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRun(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	errHandle := errors.New("handle failed")
+
+	fc := newFakeClock(first.Add(-time.Second))
+	st := New(first, interval, WithClock(fc))
+	handled := make(chan time.Time)
+	res := make(chan error, 1)
+	go func() {
+		res <- st.Run(context.Background(), func(_ context.Context, t time.Time) error {
+			handled <- t
+			if t.Equal(first.Add(interval)) {
+				return errHandle
+			}
+			return nil
+		})
+	}()
+
+	for i := 0; i < 2; i++ {
+		next := first.Add(time.Duration(i) * interval)
+		fc.expectTimer(t, next)
+		fc.Set(next)
+		if tick := receive(t, handled); !tick.Equal(next) {
+			t.Errorf("expected tick at %v, but got %v", next, tick)
+		}
+	}
+	if err := receive(t, res); !errors.Is(err, errHandle) {
+		t.Errorf("expected %v, but got %v", errHandle, err)
+	}
+	select {
+	case <-st.ctx().Done():
+	default:
+		t.Error("expected ticker to be stopped")
+	}
+}
+
+func TestRunCancel(t *testing.T) {
+	st := New(time.Now(), time.Millisecond)
+	ctx, cancel := context.WithCancel(context.Background())
+	res := make(chan error, 1)
+	go func() {
+		res <- st.Run(ctx, func(context.Context, time.Time) error {
+			return nil
+		})
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	if err := receive(t, res); err != nil {
+		t.Errorf("expected nil error, but got %v", err)
+	}
+	select {
+	case <-st.ctx().Done():
+	default:
+		t.Error("expected ticker to be stopped")
+	}
+}
+
+func TestRunInterceptor(t *testing.T) {
+	type key struct{}
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(first.Add(-time.Second))
+	var intercepted []time.Time
+	st := New(first, time.Minute, WithClock(fc), WithRunInterceptor(func(ctx context.Context, t time.Time, handle func(context.Context, time.Time) error) error {
+		intercepted = append(intercepted, t)
+		return handle(context.WithValue(ctx, key{}, t), t)
+	}))
+
+	errDone := errors.New("done")
+	res := make(chan error, 1)
+	go func() {
+		res <- st.Run(context.Background(), func(ctx context.Context, tick time.Time) error {
+			if v := ctx.Value(key{}); v != tick {
+				t.Errorf("expected context of interceptor for tick at %v, but got %v", tick, v)
+			}
+			return errDone
+		})
+	}()
+
+	fc.expectTimer(t, first)
+	fc.Set(first)
+	if err := receive(t, res); !errors.Is(err, errDone) {
+		t.Errorf("expected %v, but got %v", errDone, err)
+	}
+	if len(intercepted) != 1 || !intercepted[0].Equal(first) {
+		t.Errorf("expected interception of tick at %v, but got %v", first, intercepted)
+	}
+}
+
+func TestTickTimeout(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	st := New(first, interval, WithTickTimeout(10*time.Millisecond), WithClock(fc))
+	defer st.Stop()
+
+	cancelled := make(chan error, 1)
+	handled := make(chan time.Time, 2)
+	go st.Run(context.Background(), func(ctx context.Context, tick time.Time) error {
+		handled <- tick
+		if tick.Equal(first) {
+			// Stall until the timeout cancels the context.
+			<-ctx.Done()
+			cancelled <- ctx.Err()
+			return ctx.Err()
+		}
+		return nil
+	})
+
+	fc.expectTimer(t, first)
+	fc.Set(first)
+	receive(t, handled)
+	if err := receive(t, cancelled); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected %v, but got %v", context.DeadlineExceeded, err)
+	}
+
+	// The next tick is handled although the handler of the first one stalled.
+	next := first.Add(interval)
+	fc.expectTimer(t, next)
+	fc.Set(next)
+	if tick := receive(t, handled); !tick.Equal(next) {
+		t.Errorf("expected tick at %v, but got %v", next, tick)
+	}
+	if s := st.Stats(); s.TimedOut != 1 {
+		t.Errorf("expected 1 timed out tick, but got %d", s.TimedOut)
+	}
+}
+
+func TestRunConcurrency(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	st := New(first, interval, WithConcurrency(3), WithClock(fc))
+
+	started := make(chan time.Time, 10)
+	release := make(chan struct{})
+	var mu sync.Mutex
+	inflight, most := 0, 0
+	res := make(chan error, 1)
+	go func() {
+		res <- st.Run(context.Background(), func(_ context.Context, tick time.Time) error {
+			mu.Lock()
+			inflight++
+			if inflight > most {
+				most = inflight
+			}
+			mu.Unlock()
+			started <- tick
+			<-release
+			mu.Lock()
+			inflight--
+			mu.Unlock()
+			return nil
+		})
+	}()
+
+	// Three slow handlers run at the same time.
+	for i := 0; i < 3; i++ {
+		next := first.Add(time.Duration(i) * interval)
+		fc.expectTimer(t, next)
+		fc.Set(next)
+		if tick := receive(t, started); !tick.Equal(next) {
+			t.Errorf("expected handling of tick at %v, but got %v", next, tick)
+		}
+	}
+	// Further ticks wait for a free worker, at most one of them.
+	for i := 3; i < 5; i++ {
+		next := first.Add(time.Duration(i) * interval)
+		fc.expectTimer(t, next)
+		fc.Set(next)
+	}
+	fc.expectTimer(t, first.Add(5*interval))
+	expectNothing(t, started)
+
+	release <- struct{}{}
+	if tick, want := receive(t, started), first.Add(3*interval); !tick.Equal(want) {
+		t.Errorf("expected handling of pending tick at %v, but got %v", want, tick)
+	}
+	close(release)
+	expectNothing(t, started)
+	st.Stop()
+	if err := receive(t, res); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if most != 3 {
+		t.Errorf("expected 3 handlers in flight at most, but got %d", most)
+	}
+}
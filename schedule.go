@@ -0,0 +1,150 @@
+package sticker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// schedule determines the points in time a ticker ticks at if they
+// cannot be expressed by a first start and an interval.
+type schedule interface {
+	// next returns the first point in time of the schedule after t.
+	// The zero time means that the schedule is over.
+	next(t time.Time) time.Time
+}
+
+// reversibleSchedule is a schedule that can also look back.
+type reversibleSchedule interface {
+	schedule
+
+	// prev returns the last point in time of the schedule at or before t.
+	prev(t time.Time) time.Time
+}
+
+// Schedule determines the points in time a ticker created by NewFromSchedule ticks at,
+// e.g. for recurrence rules that cannot be expressed by a first start and an interval.
+type Schedule interface {
+	// Next returns the first point in time of the schedule after after.
+	// The zero time means that the schedule is over and the ticker stops.
+	// Next is called from the goroutines of the ticker and must be safe for concurrent use.
+	Next(after time.Time) time.Time
+}
+
+// NewFromSchedule returns a new ScheduledTicker that ticks at the points in time of s.
+// The nominal interval of the ticker, e.g. for WithJitterFraction, is the distance between the
+// first two points in time of s. If s has no point in time in the future the ticker is stopped right away.
+// s must not be nil; if it is, NewFromSchedule will panic.
+// Stop the ticker to release associated resources.
+func NewFromSchedule(s Schedule, opts ...Option) *ScheduledTicker {
+	if s == nil {
+		panic(errors.New("nil schedule for NewFromSchedule ScheduledTicker"))
+	}
+	ticker := newChanTicker(context.Background(), opts)
+	var interval time.Duration
+	if first := s.Next(ticker.clock.Now()); !first.IsZero() {
+		if second := s.Next(first); !second.IsZero() {
+			interval = second.Sub(first)
+		}
+	}
+	ticker.startSchedule(externalSchedule{s}, interval)
+	return ticker
+}
+
+// externalSchedule adapts a Schedule.
+type externalSchedule struct {
+	s Schedule
+}
+
+func (e externalSchedule) next(t time.Time) time.Time {
+	return e.s.Next(t)
+}
+
+// day is the nominal interval of daily schedules.
+const day = 24 * time.Hour
+
+// NewEveryMinute returns a new ScheduledTicker that ticks at the start of every minute.
+// Stop the ticker to release associated resources.
+func NewEveryMinute(opts ...Option) *ScheduledTicker {
+	ticker := newChanTicker(context.Background(), opts)
+	ticker.start(ticker.clock.Now().Truncate(time.Minute), time.Minute)
+	return ticker
+}
+
+// NewEveryHour returns a new ScheduledTicker that ticks at the start of every hour of the local time.
+// Stop the ticker to release associated resources.
+func NewEveryHour(opts ...Option) *ScheduledTicker {
+	ticker := newChanTicker(context.Background(), opts)
+	now := ticker.clock.Now().In(time.Local)
+	ticker.start(time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), 0, 0, 0, time.Local), time.Hour)
+	return ticker
+}
+
+// NewEveryDay returns a new ScheduledTicker that ticks every day at the time of day at in loc,
+// e.g. 9*time.Hour+30*time.Minute for 09:30. The time of day is kept across daylight saving time changes.
+// If it does not exist on a day because of such a change, the tick is normalized like [time.Date] does.
+// The duration at must be within [0, 24h) and loc must not be nil; if not, NewEveryDay will panic.
+// Stop the ticker to release associated resources.
+func NewEveryDay(at time.Duration, loc *time.Location, opts ...Option) *ScheduledTicker {
+	if at < 0 || at >= day {
+		panic(errors.New("time of day out of range for NewEveryDay ScheduledTicker"))
+	}
+	if loc == nil {
+		panic(errors.New("nil location for NewEveryDay ScheduledTicker"))
+	}
+	ticker := newChanTicker(context.Background(), opts)
+	ticker.startSchedule(daily{at: at, loc: loc}, day)
+	return ticker
+}
+
+// NewDailyAt is like NewEveryDay but takes the time of day as a clock time "HH:MM" in 24-hour format, e.g. "09:30".
+// The first tick is the next occurrence of that time in loc, i.e. today if it is still upcoming and tomorrow otherwise.
+// An error is returned if hhmm is not a valid clock time or loc is nil.
+func NewDailyAt(hhmm string, loc *time.Location, opts ...Option) (*ScheduledTicker, error) {
+	at, err := time.Parse("15:04", hhmm)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time of day %q for NewDailyAt: %w", hhmm, err)
+	}
+	if loc == nil {
+		return nil, errors.New("nil location for NewDailyAt")
+	}
+	return NewEveryDay(time.Duration(at.Hour())*time.Hour+time.Duration(at.Minute())*time.Minute, loc, opts...), nil
+}
+
+// daily is the schedule of a tick every day at the same time of day.
+type daily struct {
+	at  time.Duration // Time of day as offset from midnight.
+	loc *time.Location
+}
+
+func (d daily) next(t time.Time) time.Time {
+	n := d.on(t, 0)
+	if !n.After(t) {
+		n = d.on(t, 1)
+	}
+	return n
+}
+
+func (d daily) prev(t time.Time) time.Time {
+	p := d.on(t, 0)
+	if p.After(t) {
+		p = d.on(t, -1)
+	}
+	return p
+}
+
+// on returns the tick on the day that is days after the day of t.
+func (d daily) on(t time.Time, days int) time.Time {
+	y, m, dd := t.In(d.loc).Date()
+	return dateAt(y, m, dd+days, d.at, d.loc)
+}
+
+// dateAt returns the point in time at the time of day at on the given day in loc. Like time.Date it
+// normalizes the day. at is added as wall clock time instead of as elapsed time, which would be off on
+// days with daylight saving time changes.
+func dateAt(y int, m time.Month, d int, at time.Duration, loc *time.Location) time.Time {
+	// NOTE: pass the components separately since the nanoseconds of a day overflow an int on 32-bit platforms.
+	hour, min, sec := at/time.Hour, at%time.Hour/time.Minute, at%time.Minute/time.Second
+	return time.Date(y, m, d, int(hour), int(min), int(sec), int(at%time.Second), loc)
+}
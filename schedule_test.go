@@ -0,0 +1,209 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewEveryMinute(t *testing.T) {
+	st := NewEveryMinute()
+	defer st.Stop()
+
+	next := st.NextTick()
+	if next.Second() != 0 || next.Nanosecond() != 0 {
+		t.Errorf("expected first tick at the start of a minute, but got %v", next)
+	}
+	if until := time.Until(next); until <= 0 || until > time.Minute {
+		t.Errorf("expected first tick within the next minute, but got %v", next)
+	}
+}
+
+func TestNewEveryHour(t *testing.T) {
+	st := NewEveryHour()
+	defer st.Stop()
+
+	next := st.NextTick().In(time.Local)
+	if next.Minute() != 0 || next.Second() != 0 || next.Nanosecond() != 0 {
+		t.Errorf("expected first tick at the start of an hour, but got %v", next)
+	}
+	if until := time.Until(next); until <= 0 || until > time.Hour {
+		t.Errorf("expected first tick within the next hour, but got %v", next)
+	}
+}
+
+func TestNewEveryDay(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+
+	// Daylight saving time starts in the night to 2023-03-26.
+	now := time.Date(2023, 3, 25, 10, 0, 0, 0, berlin)
+	fc := newFakeClock(now)
+	st := NewEveryDay(9*time.Hour, berlin, WithClock(fc))
+	defer st.Stop()
+
+	for _, want := range []time.Time{
+		time.Date(2023, 3, 26, 9, 0, 0, 0, berlin),
+		time.Date(2023, 3, 27, 9, 0, 0, 0, berlin),
+	} {
+		fc.expectTimer(t, want)
+		fc.Set(want)
+		if tick := receive(t, st.C); !tick.Equal(want) {
+			t.Errorf("expected tick at %v, but got %v", want, tick)
+		}
+	}
+	if last, want := st.LastBoundary(), time.Date(2023, 3, 27, 9, 0, 0, 0, berlin); !last.Equal(want) {
+		t.Errorf("expected last boundary %v, but got %v", want, last)
+	}
+}
+
+func TestNewDailyAt(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		name string
+		hhmm string
+		want time.Time
+	}{
+		{
+			name: "upcoming",
+			hhmm: "17:45",
+			want: time.Date(2023, 6, 1, 17, 45, 0, 0, time.UTC),
+		},
+		{
+			name: "past",
+			hhmm: "09:30",
+			want: time.Date(2023, 6, 2, 9, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "exact",
+			hhmm: "12:00",
+			want: time.Date(2023, 6, 2, 12, 0, 0, 0, time.UTC),
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			st, err := NewDailyAt(tc.hhmm, time.UTC, WithClock(newFakeClock(now)))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer st.Stop()
+			if next := st.NextTick(); !next.Equal(tc.want) {
+				t.Errorf("expected first tick at %v, but got %v", tc.want, next)
+			}
+		})
+	}
+}
+
+func TestNewDailyAtInvalid(t *testing.T) {
+	for _, hhmm := range []string{"", "9:30am", "24:00", "12:60", "noon"} {
+		if _, err := NewDailyAt(hhmm, time.UTC); err == nil {
+			t.Errorf("expected error for %q", hhmm)
+		}
+	}
+	if _, err := NewDailyAt("09:30", nil); err == nil {
+		t.Error("expected error for nil location")
+	}
+}
+
+func TestDaily(t *testing.T) {
+	d := daily{at: 9*time.Hour + 30*time.Minute, loc: time.UTC}
+	cases := []struct {
+		name string
+		t    time.Time
+		next time.Time
+		prev time.Time
+	}{
+		{
+			name: "beforeToday",
+			t:    time.Date(2023, 6, 1, 8, 0, 0, 0, time.UTC),
+			next: time.Date(2023, 6, 1, 9, 30, 0, 0, time.UTC),
+			prev: time.Date(2023, 5, 31, 9, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "exact",
+			t:    time.Date(2023, 6, 1, 9, 30, 0, 0, time.UTC),
+			next: time.Date(2023, 6, 2, 9, 30, 0, 0, time.UTC),
+			prev: time.Date(2023, 6, 1, 9, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "afterToday",
+			t:    time.Date(2023, 12, 31, 23, 0, 0, 0, time.UTC),
+			next: time.Date(2024, 1, 1, 9, 30, 0, 0, time.UTC),
+			prev: time.Date(2023, 12, 31, 9, 30, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if next := d.next(tc.t); !next.Equal(tc.next) {
+				t.Errorf("expected next %v, but got %v", tc.next, next)
+			}
+			if prev := d.prev(tc.t); !prev.Equal(tc.prev) {
+				t.Errorf("expected prev %v, but got %v", tc.prev, prev)
+			}
+		})
+	}
+}
+
+// doubling is a Schedule whose intervals double from tick to tick until it ends at last.
+type doubling struct {
+	start time.Time
+	last  time.Time
+}
+
+func (d doubling) Next(after time.Time) time.Time {
+	for p := d.start; !p.After(d.last); p = p.Add(p.Sub(d.start) + time.Second) {
+		if p.After(after) {
+			return p
+		}
+	}
+	return time.Time{}
+}
+
+func TestNewFromSchedule(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(start.Add(-time.Second))
+	s := doubling{start: start, last: start.Add(7 * time.Second)}
+	st := NewFromSchedule(s, WithClock(fc))
+	defer st.Stop()
+
+	if st.interval != time.Second {
+		t.Errorf("expected nominal interval %v, but got %v", time.Second, st.interval)
+	}
+	for _, offset := range []time.Duration{0, time.Second, 3 * time.Second, 7 * time.Second} {
+		next := start.Add(offset)
+		fc.expectTimer(t, next)
+		fc.Set(next)
+		if tick := receive(t, st.C); !tick.Equal(next) {
+			t.Errorf("expected tick at %v, but got %v", next, tick)
+		}
+	}
+	for !st.Stopped() {
+		time.Sleep(time.Millisecond)
+	}
+
+	// A schedule that is over stops the ticker right away.
+	if st := NewFromSchedule(s, WithClock(newFakeClock(start.Add(time.Minute)))); !st.Stopped() {
+		t.Error("expected ticker of a schedule that is over to be stopped")
+	}
+}
+
+func TestNewFromScheduleAutoPause(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(start.Add(-time.Second))
+	st := NewFromSchedule(doubling{start: start, last: start.Add(7 * time.Second)}, WithAutoPause(), WithClock(fc))
+	defer st.Stop()
+
+	// Without a subscriber there is no next tick, but the schedule is not over.
+	if st.Stopped() {
+		t.Fatal("expected auto-paused ticker not to be stopped")
+	}
+	sub := st.Subscribe()
+	fc.expectTimer(t, start)
+	fc.Set(start)
+	if tick := receive(t, sub); !tick.Equal(start) {
+		t.Errorf("expected tick at %v, but got %v", start, tick)
+	}
+}
@@ -0,0 +1,65 @@
+package sticker
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// NewSequence returns a new ScheduledTicker that ticks at first and then after each of intervals in turn,
+// e.g. after 1m, 5m and 15m for a custom backoff. If repeat is true the ticker starts over with the first
+// interval after the last one, otherwise it stops itself after the tick that ends the last interval.
+// Ticks in the past are skipped like with New. A zero first means now.
+// intervals must not be empty and all of them must be greater than zero; if not, NewSequence will panic.
+// Stop the ticker to release associated resources.
+func NewSequence(first time.Time, intervals []time.Duration, repeat bool, opts ...Option) *ScheduledTicker {
+	if len(intervals) == 0 {
+		panic(errors.New("no intervals for NewSequence ScheduledTicker"))
+	}
+	var cycle time.Duration
+	for _, d := range intervals {
+		if d <= 0 {
+			panic(errors.New("non-positive interval for NewSequence ScheduledTicker"))
+		}
+		cycle += d
+	}
+	ticker := newChanTicker(context.Background(), opts)
+	if first.IsZero() {
+		first = ticker.clock.Now()
+	}
+	s := sequence{
+		first:     first,
+		intervals: append([]time.Duration(nil), intervals...),
+		cycle:     cycle,
+		repeat:    repeat,
+	}
+	// NOTE: startSchedule stops the ticker if all ticks of the sequence are in the past.
+	ticker.startSchedule(s, cycle/time.Duration(len(intervals)))
+	return ticker
+}
+
+// sequence is the schedule of ticks whose intervals follow a list of durations.
+type sequence struct {
+	first     time.Time
+	intervals []time.Duration
+	cycle     time.Duration // The sum of intervals.
+	repeat    bool
+}
+
+func (s sequence) next(t time.Time) time.Time {
+	p := s.first
+	if s.repeat && t.After(p) {
+		// Skip whole cycles at once.
+		p = p.Add(t.Sub(p) / s.cycle * s.cycle)
+	}
+	for i := 0; !p.After(t); i++ {
+		if i == len(s.intervals) {
+			if !s.repeat {
+				return time.Time{}
+			}
+			i = 0
+		}
+		p = p.Add(s.intervals[i])
+	}
+	return p
+}
@@ -0,0 +1,116 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSequence(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	intervals := []time.Duration{time.Minute, 5 * time.Minute, 15 * time.Minute}
+
+	for _, tc := range []struct {
+		name    string
+		repeat  bool
+		offsets []time.Duration
+	}{
+		{
+			name:    "once",
+			offsets: []time.Duration{0, time.Minute, 6 * time.Minute, 21 * time.Minute},
+		},
+		{
+			name:    "repeat",
+			repeat:  true,
+			offsets: []time.Duration{0, time.Minute, 6 * time.Minute, 21 * time.Minute, 22 * time.Minute, 27 * time.Minute, 42 * time.Minute},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			fc := newFakeClock(first.Add(-time.Second))
+			st := NewSequence(first, intervals, tc.repeat, WithClock(fc))
+			defer st.Stop()
+
+			for _, offset := range tc.offsets {
+				next := first.Add(offset)
+				fc.expectTimer(t, next)
+				fc.Set(next)
+				if tick := receive(t, st.C); !tick.Equal(next) {
+					t.Errorf("expected tick at %v, but got %v", next, tick)
+				}
+			}
+			if !tc.repeat {
+				for !st.Stopped() {
+					time.Sleep(time.Millisecond)
+				}
+				if next := st.NextTick(); !next.IsZero() {
+					t.Errorf("expected no next tick, but got %v", next)
+				}
+			}
+		})
+	}
+}
+
+func TestSequenceNext(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := sequence{
+		first:     first,
+		intervals: []time.Duration{time.Second, 2 * time.Second},
+		cycle:     3 * time.Second,
+		repeat:    true,
+	}
+	for _, tc := range []struct {
+		t, want time.Duration
+	}{
+		{-time.Second, 0},
+		{0, time.Second},
+		{time.Second, 3 * time.Second},
+		{2 * time.Second, 3 * time.Second},
+		{3 * time.Second, 4 * time.Second},
+		{301*time.Second + time.Millisecond, 303 * time.Second},
+	} {
+		if next := s.next(first.Add(tc.t)); !next.Equal(first.Add(tc.want)) {
+			t.Errorf("expected next tick after %v at %v, but got %v", tc.t, tc.want, next.Sub(first))
+		}
+	}
+	s.repeat = false
+	if next := s.next(first.Add(3 * time.Second)); !next.IsZero() {
+		t.Errorf("expected end of sequence, but got %v", next)
+	}
+}
+
+func TestSequenceInvalid(t *testing.T) {
+	for _, intervals := range [][]time.Duration{nil, {time.Second, 0}} {
+		func() {
+			defer func() {
+				if err := recover(); err == nil {
+					t.Errorf("NewSequence(%v) should have panicked", intervals)
+				}
+			}()
+			NewSequence(time.Time{}, intervals, true).Stop()
+		}()
+	}
+}
+
+func TestSequenceAutoPause(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	intervals := []time.Duration{time.Minute, 5 * time.Minute}
+	fc := newFakeClock(first.Add(-time.Second))
+	st := NewSequence(first, intervals, false, WithAutoPause(), WithClock(fc))
+	defer st.Stop()
+
+	if st.Stopped() {
+		t.Fatal("expected auto-paused ticker not to be stopped")
+	}
+	sub := st.Subscribe()
+	fc.expectTimer(t, first)
+	fc.Set(first)
+	if tick := receive(t, sub); !tick.Equal(first) {
+		t.Errorf("expected tick at %v, but got %v", first, tick)
+	}
+
+	// A sequence whose ticks are all in the past is stopped right away.
+	past := NewSequence(first, intervals, false, WithClock(newFakeClock(first.Add(time.Hour))))
+	if !past.Stopped() {
+		t.Error("expected ticker of a sequence in the past to be stopped")
+	}
+}
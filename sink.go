@@ -0,0 +1,99 @@
+package sticker
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Sink receives the ticks of a ticker created by NewToSink, e.g. to publish them to a message queue.
+type Sink interface {
+	// Deliver delivers the tick at t. ctx is done once the ticker is stopped.
+	Deliver(ctx context.Context, t time.Time) error
+}
+
+// SinkErrorPolicy defines how a ticker created by NewToSink handles errors returned by its Sink.
+type SinkErrorPolicy int
+
+const (
+	// DropOnSinkError drops a tick whose delivery failed.
+	DropOnSinkError SinkErrorPolicy = iota
+
+	// RetryOnSinkError retries the delivery of a tick after a short delay until it succeeds.
+	// The tick is dropped if the next tick would be due before the retry.
+	RetryOnSinkError
+
+	// StopOnSinkError stops the ticker once the delivery of a tick failed.
+	StopOnSinkError
+)
+
+// sinkRetryDelay is how long RetryOnSinkError waits before retrying a delivery.
+const sinkRetryDelay = 100 * time.Millisecond
+
+// WithSinkErrorPolicy sets how a ticker created by NewToSink handles errors of its Sink.
+// The default is DropOnSinkError.
+func WithSinkErrorPolicy(p SinkErrorPolicy) Option {
+	return func(st *ScheduledTicker) {
+		st.sinkErrors = p
+	}
+}
+
+// NewToSink returns a new ScheduledTicker like New that delivers its ticks to sink instead of on C, which is nil.
+// sink is called from a goroutine of the ticker, one tick at a time. Ticks that are due while sink is
+// still delivering the previous one are dropped. Errors returned by sink are handled according to the
+// SinkErrorPolicy of the ticker. The duration interval must be greater than zero and sink must not be nil;
+// if not, NewToSink will panic. Stop the ticker to release associated resources.
+func NewToSink(first time.Time, interval time.Duration, sink Sink, opts ...Option) *ScheduledTicker {
+	if interval <= 0 {
+		panic(errors.New("non-positive interval for NewToSink ScheduledTicker"))
+	}
+	if sink == nil {
+		panic(errors.New("nil sink for NewToSink ScheduledTicker"))
+	}
+	ticker := newChanTicker(context.Background(), opts)
+	// NOTE: the goroutine delivering to sink is not restarted.
+	ticker.final = true
+	c := ticker.C
+	ticker.C = nil
+	ticker.start(first, interval)
+	go func() {
+		for {
+			select {
+			case <-ticker.ctx().Done():
+				return
+			case t, ok := <-c:
+				if !ok {
+					return
+				}
+				if err := ticker.deliverToSink(ticker.ctx(), sink, t); err != nil {
+					ticker.Stop()
+					return
+				}
+			}
+		}
+	}()
+	return ticker
+}
+
+// deliverToSink delivers the tick at t to sink handling errors according to the SinkErrorPolicy.
+func (st *ScheduledTicker) deliverToSink(ctx context.Context, sink Sink, t time.Time) error {
+	for {
+		err := sink.Deliver(ctx, t)
+		if err == nil || st.sinkErrors == DropOnSinkError {
+			return nil
+		}
+		if st.sinkErrors == StopOnSinkError {
+			return err
+		}
+		if next := st.NextTick(); !next.IsZero() && !st.clock.Now().Add(sinkRetryDelay).Before(next) {
+			return nil
+		}
+		timer := st.clock.NewTimer(sinkRetryDelay)
+		select {
+		case <-timer.C():
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package sticker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// failingSink fails the first delivery and records the times of all delivery attempts.
+type failingSink struct {
+	attempts chan time.Time
+	failed   bool
+}
+
+func (s *failingSink) Deliver(_ context.Context, t time.Time) error {
+	s.attempts <- t
+	if !s.failed {
+		s.failed = true
+		return errors.New("delivery failed")
+	}
+	return nil
+}
+
+func TestSinkErrorPolicy(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+
+	for _, tc := range []struct {
+		name   string
+		policy SinkErrorPolicy
+	}{
+		{name: "drop", policy: DropOnSinkError},
+		{name: "retry", policy: RetryOnSinkError},
+		{name: "stop", policy: StopOnSinkError},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			fc := newFakeClock(first.Add(-time.Second))
+			sink := &failingSink{attempts: make(chan time.Time, 10)}
+			st := NewToSink(first, interval, sink, WithSinkErrorPolicy(tc.policy), WithClock(fc))
+			defer st.Stop()
+
+			fc.expectTimer(t, first)
+			fc.Set(first)
+			if attempt := receive(t, sink.attempts); !attempt.Equal(first) {
+				t.Errorf("expected delivery of tick at %v, but got %v", first, attempt)
+			}
+
+			switch tc.policy {
+			case DropOnSinkError:
+				expectNothing(t, sink.attempts)
+			case RetryOnSinkError:
+				fc.expectTimer(t, first.Add(sinkRetryDelay))
+				fc.Set(first.Add(sinkRetryDelay))
+				if attempt := receive(t, sink.attempts); !attempt.Equal(first) {
+					t.Errorf("expected retry of tick at %v, but got %v", first, attempt)
+				}
+			case StopOnSinkError:
+				deadline := time.Now().Add(time.Second)
+				for !st.Stopped() && time.Now().Before(deadline) {
+					time.Sleep(time.Millisecond)
+				}
+				if !st.Stopped() {
+					t.Fatal("expected ticker to be stopped")
+				}
+				return
+			}
+
+			next := first.Add(interval)
+			fc.expectTimer(t, next)
+			fc.Set(next)
+			if attempt := receive(t, sink.attempts); !attempt.Equal(next) {
+				t.Errorf("expected delivery of tick at %v, but got %v", next, attempt)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package sticker
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Stage is a part of the schedule of NewStaged.
+type Stage struct {
+	Count    int           // Number of ticks of the stage. Zero repeats the ticks of the last stage forever.
+	Interval time.Duration // The period between the ticks of the stage and the first tick of the next one.
+}
+
+// NewStaged returns a new ScheduledTicker that ticks at first and moves through stages, e.g. to tick every
+// second for the first 10 ticks during warm-up and every minute after that. Each stage fires Count ticks
+// Interval apart, and the first tick of the next stage follows Interval after the last one. If the Count
+// of the last stage is zero it repeats forever, otherwise the ticker stops itself after its last tick.
+// Ticks in the past are skipped like with New. A zero first means now.
+// stages must not be empty, all Intervals must be greater than zero and all Counts must be greater than
+// zero except for the one of the last stage which must not be negative; if not, NewStaged will panic.
+// Stop the ticker to release associated resources.
+func NewStaged(first time.Time, stages []Stage, opts ...Option) *ScheduledTicker {
+	if len(stages) == 0 {
+		panic(errors.New("no stages for NewStaged ScheduledTicker"))
+	}
+	for i, s := range stages {
+		if s.Interval <= 0 {
+			panic(errors.New("non-positive interval for NewStaged ScheduledTicker"))
+		}
+		if s.Count < 0 || s.Count == 0 && i < len(stages)-1 {
+			panic(errors.New("invalid count for NewStaged ScheduledTicker"))
+		}
+	}
+	ticker := newChanTicker(context.Background(), opts)
+	if first.IsZero() {
+		first = ticker.clock.Now()
+	}
+	s := staged{first: first, stages: append([]Stage(nil), stages...)}
+	// NOTE: startSchedule stops the ticker if all ticks of the stages are in the past.
+	ticker.startSchedule(s, stages[len(stages)-1].Interval)
+	return ticker
+}
+
+// staged is the schedule of ticks moving through stages of different intervals.
+type staged struct {
+	first  time.Time
+	stages []Stage
+}
+
+func (s staged) next(t time.Time) time.Time {
+	start := s.first
+	for _, stage := range s.stages {
+		if t.Before(start) {
+			return start
+		}
+		if stage.Count == 0 {
+			return NextRun(start, stage.Interval, t)
+		}
+		if k := int64(t.Sub(start)/stage.Interval) + 1; k < int64(stage.Count) {
+			return start.Add(time.Duration(k) * stage.Interval)
+		}
+		start = start.Add(time.Duration(stage.Count) * stage.Interval)
+	}
+	return time.Time{}
+}
@@ -0,0 +1,96 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStaged(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	stages := []Stage{
+		{Count: 3, Interval: time.Second},
+		{Count: 2, Interval: time.Minute},
+	}
+
+	for _, tc := range []struct {
+		name    string
+		last    Stage
+		offsets []time.Duration
+	}{
+		{
+			name:    "repeat",
+			last:    Stage{Interval: time.Hour},
+			offsets: []time.Duration{0, time.Second, 2 * time.Second, 3 * time.Second, 63 * time.Second, 123 * time.Second, time.Hour + 123*time.Second},
+		},
+		{
+			name:    "stop",
+			last:    Stage{Count: 1, Interval: time.Hour},
+			offsets: []time.Duration{0, time.Second, 2 * time.Second, 3 * time.Second, 63 * time.Second, 123 * time.Second},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			fc := newFakeClock(first.Add(-time.Second))
+			st := NewStaged(first, append(stages, tc.last), WithClock(fc))
+			defer st.Stop()
+
+			for _, offset := range tc.offsets {
+				next := first.Add(offset)
+				fc.expectTimer(t, next)
+				fc.Set(next)
+				if tick := receive(t, st.C); !tick.Equal(next) {
+					t.Errorf("expected tick at %v, but got %v", next, tick)
+				}
+			}
+			if tc.last.Count > 0 {
+				for !st.Stopped() {
+					time.Sleep(time.Millisecond)
+				}
+			} else if next, want := st.NextTick(), first.Add(2*time.Hour+123*time.Second); !next.Equal(want) {
+				t.Errorf("expected next tick at %v, but got %v", want, next)
+			}
+		})
+	}
+}
+
+func TestStagedInvalid(t *testing.T) {
+	for _, stages := range [][]Stage{
+		nil,
+		{{Count: 1, Interval: 0}},
+		{{Count: -1, Interval: time.Second}},
+		{{Count: 0, Interval: time.Second}, {Count: 1, Interval: time.Second}},
+	} {
+		func() {
+			defer func() {
+				if err := recover(); err == nil {
+					t.Errorf("NewStaged(%v) should have panicked", stages)
+				}
+			}()
+			NewStaged(time.Time{}, stages).Stop()
+		}()
+	}
+}
+
+func TestStagedAutoPause(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	stages := []Stage{{Count: 2, Interval: time.Second}, {Count: 1, Interval: time.Minute}}
+	fc := newFakeClock(first.Add(-time.Second))
+	st := NewStaged(first, stages, WithAutoPause(), WithClock(fc))
+	defer st.Stop()
+
+	if st.Stopped() {
+		t.Fatal("expected auto-paused ticker not to be stopped")
+	}
+	sub := st.Subscribe()
+	fc.expectTimer(t, first)
+	fc.Set(first)
+	if tick := receive(t, sub); !tick.Equal(first) {
+		t.Errorf("expected tick at %v, but got %v", first, tick)
+	}
+
+	// Stages whose ticks are all in the past stop the ticker right away.
+	past := NewStaged(first, stages, WithClock(newFakeClock(first.Add(time.Hour))))
+	if !past.Stopped() {
+		t.Error("expected ticker of stages in the past to be stopped")
+	}
+}
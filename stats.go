@@ -0,0 +1,71 @@
+package sticker
+
+import "time"
+
+// Stats are counters of the ticks of a ScheduledTicker.
+type Stats struct {
+	Delivered uint64 // Number of ticks delivered.
+	Dropped   uint64 // Number of ticks dropped because C was full.
+	Skipped   uint64 // Number of ticks skipped by the predicate of WithPredicate or the gate of WithGate.
+	TimedOut  uint64 // Number of ticks whose handler in Run exceeded the timeout of WithTickTimeout.
+	Restarts  uint64 // Number of times WithSupervisor restarted the goroutine of the ticker after a panic.
+}
+
+// Stats returns the counters of the ticker since it was created or since the last ResetStats.
+func (st *ScheduledTicker) Stats() Stats {
+	if st == nil {
+		return Stats{}
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return st.stats
+}
+
+// latencyBounds are the upper bounds of the buckets of LatencyBuckets.
+var latencyBounds = [...]time.Duration{time.Millisecond, 10 * time.Millisecond, 100 * time.Millisecond, time.Second}
+
+// LatencyBuckets returns how many of the delivered ticks fired within 1ms, 10ms, 100ms and 1s of the
+// point in time they were scheduled for, keyed by these bounds. The buckets are cumulative, i.e. a tick
+// that fired within 1ms is counted in all of them, and ticks that fired later than 1s are in none of them.
+// Ticks fired early by jitter count by how early they fired. Ticks of Fire are not counted.
+// Like the counters of Stats the buckets are set back to zero by ResetStats.
+func (st *ScheduledTicker) LatencyBuckets() map[time.Duration]uint64 {
+	if st == nil {
+		return nil
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	buckets := make(map[time.Duration]uint64, len(latencyBounds))
+	for i, bound := range latencyBounds {
+		buckets[bound] = st.latency[i]
+	}
+	return buckets
+}
+
+// recordLatency counts t in the buckets of LatencyBuckets. st.mu must be held.
+func (st *ScheduledTicker) recordLatency(t Tick) {
+	if t.Manual {
+		return
+	}
+	latency := t.Drift()
+	if latency < 0 {
+		latency = -latency
+	}
+	for i, bound := range latencyBounds {
+		if latency <= bound {
+			st.latency[i]++
+		}
+	}
+}
+
+// ResetStats sets the counters returned by Stats back to zero, e.g. to count ticks per time window.
+// Unlike Reset it does not affect the schedule.
+func (st *ScheduledTicker) ResetStats() {
+	if st == nil {
+		return
+	}
+	st.mu.Lock()
+	st.stats = Stats{}
+	st.latency = [len(latencyBounds)]uint64{}
+	st.mu.Unlock()
+}
@@ -0,0 +1,78 @@
+package sticker
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestStats(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	st := New(first, interval, WithClock(fc))
+	defer st.Stop()
+
+	advance := func(i int) {
+		t.Helper()
+		next := first.Add(time.Duration(i) * interval)
+		fc.expectTimer(t, next)
+		fc.Set(next)
+	}
+
+	// The first tick is delivered and the second one dropped since the first was not received.
+	advance(0)
+	advance(1)
+	fc.expectTimer(t, first.Add(2*interval))
+	receive(t, st.C)
+	if s, want := st.Stats(), (Stats{Delivered: 1, Dropped: 1}); s != want {
+		t.Errorf("expected %+v, but got %+v", want, s)
+	}
+
+	st.ResetStats()
+	if s := st.Stats(); s != (Stats{}) {
+		t.Errorf("expected zero stats after ResetStats, but got %+v", s)
+	}
+
+	// Ticks continue on schedule and are counted from zero.
+	advance(2)
+	if tick, want := receive(t, st.C), first.Add(2*interval); !tick.Equal(want) {
+		t.Errorf("expected tick at %v, but got %v", want, tick)
+	}
+	fc.expectTimer(t, first.Add(3*interval))
+	if s, want := st.Stats(), (Stats{Delivered: 1}); s != want {
+		t.Errorf("expected %+v, but got %+v", want, s)
+	}
+}
+
+func TestLatencyBuckets(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	st := New(first, interval, WithClock(fc))
+	defer st.Stop()
+
+	for i, delay := range []time.Duration{0, 5 * time.Millisecond, 50 * time.Millisecond, 2 * time.Second} {
+		next := first.Add(time.Duration(i) * interval)
+		fc.expectTimer(t, next)
+		fc.Set(next.Add(delay))
+		receive(t, st.C)
+	}
+	fc.expectTimer(t, first.Add(4*interval))
+	want := map[time.Duration]uint64{
+		time.Millisecond:       1,
+		10 * time.Millisecond:  2,
+		100 * time.Millisecond: 3,
+		time.Second:            3,
+	}
+	if got := st.LatencyBuckets(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, but got %v", want, got)
+	}
+
+	st.ResetStats()
+	for bound, n := range st.LatencyBuckets() {
+		if n != 0 {
+			t.Errorf("expected no ticks within %v after ResetStats, but got %d", bound, n)
+		}
+	}
+}
@@ -4,129 +4,1067 @@
 package sticker
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
+// ErrStopped is returned when waiting for a ticker that is stopped.
+var ErrStopped = errors.New("sticker: ticker stopped")
+
+// ErrFarFuture is returned by TryNew and TryReset if the first start lies further in the future than
+// allowed by WithMaxFutureStart.
+var ErrFarFuture = errors.New("sticker: first start too far in the future")
+
+// Config describes the schedule of a ScheduledTicker.
+type Config struct {
+	FirstStart time.Time     // The point in time the schedule is anchored at.
+	Interval   time.Duration // The period between two ticks.
+}
+
+// Equal reports whether c and other describe the same schedule.
+// FirstStart is compared using [time.Time.Equal] so that the same instant
+// in different locations is considered equal.
+func (c Config) Equal(other Config) bool {
+	return c.FirstStart.Equal(other.FirstStart) && c.Interval == other.Interval
+}
+
 // ScheduledTicker provides a ticker similar to [time.Ticker] but can be scheduled to start at a specific point in time.
+//
+// The methods of ScheduledTicker are safe for concurrent use by multiple goroutines. In particular Reset and its
+// variants can be called from any goroutine while the ticker is running: each of them replaces the schedule as
+// a whole, so the ticker never sees the first start of one call combined with the interval of another.
+// Concurrent calls take effect in some order, and the one taking effect last determines the schedule.
+//
+// The methods of ScheduledTicker can be called on a nil *ScheduledTicker, e.g. if a feature using it is disabled.
+// It behaves like a stopped ticker without schedule: methods changing the ticker do nothing and all others
+// return zero values or, like WaitForTick, ErrStopped.
 type ScheduledTicker struct {
 	C <-chan time.Time // The channel on which the ticks are delivered.
 
-	ticks    chan time.Time
-	reset    chan time.Time
-	stop     chan struct{}
+	deliver func(t Tick, wait bool) bool
+	reset   chan struct{}
+	session atomic.Pointer[session] // The current run of the ticker.
+	parent  context.Context         // The context the ticker was created with.
+	opts    []Option                // The options the ticker was created with.
+	final   bool                    // Whether the ticker cannot be restarted after it was stopped.
+	fn      func(time.Time)         // The function called for each tick instead of delivering it on C.
+
+	mu       sync.Mutex
+	first    time.Time
 	interval time.Duration
+	next     time.Time // The point in time the next tick is scheduled for.
+	fireAt   time.Time // The point in time the next tick fires at including jitter.
+	seq      uint64    // Number of ticks fired so far.
+	dropped  uint64    // Number of ticks dropped since the last delivered one.
+	delivery *delivery // The next delivery to wait for.
+	stats    Stats
+	latency  [len(latencyBounds)]uint64 // Cumulative counts of ticks per bound of LatencyBuckets.
+	history  ring                       // Times of the last ticks.
+	inflight uint64                     // Number of ticks delivered but not yet acknowledged.
+	held     *Tick                      // The latest tick held back until an Ack.
+	subs     []*subscriber
+	sched    schedule // Overrides first and interval if set.
+	swap     *Config  // The schedule to switch to at swapAt.
+	swapAt   time.Time
+	reconfig uint64    // Number of resets applied so far.
+	paused   bool      // Whether the ticker is paused and has no next tick.
+	pausedAt time.Time // When the ticker was paused, explicitly or for lack of subscribers.
+	gateOpen bool      // Whether the gate of WithGate enables ticks.
+	backfill bool      // Whether missed ticks of the schedule fire one by one.
+	lapsed   uint64    // Number of ticks skipped since the last fired one because they were missed.
+	seqStart uint64    // The value of seq when the current session started.
+
+	resets        atomic.Uint64 // Number of resets started so far.
+	timers        atomic.Int32  // Number of timers of the loop that are not stopped yet.
+	priorityReset uint64        // The number of the last priority reset applied.
+
+	pool      *Pool      // The pool running the schedule instead of a loop if set.
+	poolEntry *poolEntry // The entry in the heap of the pool. Guarded by the mutex of the pool.
+
+	clock       Clock
+	manual      bool // Whether there is no loop and ticks are driven by Tick.
+	autoPause   bool
+	maxDelay    time.Duration
+	ready       <-chan struct{}
+	gate        <-chan bool
+	missed      MissedTickPolicy
+	coalesce    bool           // Drop the oldest pending tick instead of the new one.
+	bufferSize  int            // Capacity of C.
+	guaranteed  uint64         // Number of first ticks that are never dropped.
+	blocking    bool           // Wait for the receiver of every tick instead of dropping it.
+	loc         *time.Location // Location of delivered ticks if set.
+	heartbeat   time.Duration  // Period of heartbeats between ticks if positive.
+	historySize int
+	windowStart bool // Deliver the start of the window a tick ends instead of the tick.
+	predicate   func(scheduled time.Time) bool
+	intercept   RunInterceptor
+	ackLimit    uint64 // Maximum number of unacknowledged ticks if positive.
+	sinkErrors  SinkErrorPolicy
+	supervise   bool // Restart the loop after a panic.
+	tickTimeout time.Duration
+	concurrency int           // Maximum number of handlers run by Run at the same time.
+	grace       time.Duration // Tolerance for a first tick in the past.
+	closeOnStop bool
+	dryRun      bool // Log ticks instead of delivering them.
+	dryRunLog   func(scheduled time.Time)
+	maxFuture   time.Duration // Maximum distance of a first start into the future if positive.
+	trace       func(event string)
+	armed       chan struct{} // Closed once the loop armed its timer for the first time.
+	catchUp     bool          // Fire a missed tick on resume.
+	onReconfig  func(old, new Config)
+	minLifetime time.Duration // Minimum time the loop runs after creation.
+	onPanic     func(recovered any)
+	maxTicks    uint64    // Number of ticks after which the ticker stops if positive.
+	until       time.Time // End of the schedule if not zero.
+
+	jitterFraction float64
+	jitterDecay    struct{ initial, window time.Duration }
+	created        time.Time // When the ticker was created, the start of the decay of jitter.
+	offset         time.Duration
+	countdown      func(time.Duration) string
 }
 
 // New returns a new ScheduleTicker that starts
 // ticking at time first in the given interval.
+// If first is the zero time the schedule starts now, i.e. the first tick
+// arrives after one interval just like with [time.NewTicker].
 // The duration interval must be greater than zero; if not, New will
 // panic. Stop the ticker to release associated resources.
-func New(first time.Time, interval time.Duration) *ScheduledTicker {
+func New(first time.Time, interval time.Duration, opts ...Option) *ScheduledTicker {
 	if interval <= 0 {
 		panic(errors.New("non-positive interval for New ScheduledTicker"))
 	}
-	// Give the channel a 1-element time buffer.
+	return NewWithContext(context.Background(), first, interval, opts...)
+}
+
+// TryNew is like New but returns an error instead of panicking if interval is not greater than zero,
+// and ErrFarFuture if first lies further in the future than allowed by WithMaxFutureStart.
+func TryNew(first time.Time, interval time.Duration, opts ...Option) (*ScheduledTicker, error) {
+	if interval <= 0 {
+		return nil, errors.New("non-positive interval for TryNew ScheduledTicker")
+	}
+	ticker := newChanTicker(context.Background(), opts)
+	if err := ticker.checkFutureStart(first); err != nil {
+		ticker.Stop()
+		return nil, err
+	}
+	ticker.start(first, interval)
+	return ticker, nil
+}
+
+// NewWithContext is like New but the ticker is also stopped once ctx is done.
+// Stopping the ticker does not affect ctx.
+func NewWithContext(ctx context.Context, first time.Time, interval time.Duration, opts ...Option) *ScheduledTicker {
+	if interval <= 0 {
+		panic(errors.New("non-positive interval for NewWithContext ScheduledTicker"))
+	}
+	ticker := newChanTicker(ctx, opts)
+	ticker.start(first, interval)
+	return ticker
+}
+
+// NewReady is like New but only returns once the goroutine of the ticker is running and has armed its timer
+// for the first tick, e.g. for code that depends on the order of its setup. The first tick is not waited for.
+// The duration interval must be greater than zero; if not, NewReady will panic.
+func NewReady(first time.Time, interval time.Duration, opts ...Option) *ScheduledTicker {
+	if interval <= 0 {
+		panic(errors.New("non-positive interval for NewReady ScheduledTicker"))
+	}
+	ticker := newChanTicker(context.Background(), opts)
+	armed := make(chan struct{})
+	ticker.armed = armed
+	ticker.start(first, interval)
+	<-armed
+	return ticker
+}
+
+// newChanTicker returns a ScheduledTicker configured by opts that delivers its ticks on C.
+// It is not running until started.
+func newChanTicker(ctx context.Context, opts []Option) *ScheduledTicker {
+	ticker := newTicker(ctx, opts)
+	// Give the channel a 1-element time buffer unless configured otherwise.
 	// If the client falls behind while reading, we drop ticks
 	// on the floor until the client catches up.
-	c := make(chan time.Time, 1)
-	ticker := &ScheduledTicker{
-		ticks: c,
-		C:     c,
-		stop:  make(chan struct{}),
-		reset: make(chan time.Time),
-	}
-	go ticker.loop()
-	ticker.Reset(first, interval)
+	c := make(chan time.Time, ticker.bufferSize)
+	// Heartbeats could not be told apart from ticks on C.
+	ticker.heartbeat = 0
+	ticker.C = c
+	ticker.deliver = func(t Tick, wait bool) bool {
+		if wait {
+			return sendWait(ticker.ctx(), c, t.stamp())
+		}
+		return send(c, t.stamp(), ticker.coalesce)
+	}
+	if ticker.closeOnStop {
+		ticker.closeWhenStopped(func() { close(c) })
+	}
 	return ticker
 }
 
+// newTicker returns a ScheduledTicker configured by opts that stops once ctx is done.
+// It is not running until deliver is set and start is called.
+func newTicker(ctx context.Context, opts []Option) *ScheduledTicker {
+	st := &ScheduledTicker{
+		reset:       make(chan struct{}, 1),
+		delivery:    newDelivery(),
+		clock:       realClock{},
+		missed:      DefaultMissedTickPolicy,
+		countdown:   formatCountdown,
+		historySize: defaultHistorySize,
+		bufferSize:  1,
+	}
+	st.parent, st.opts = ctx, opts
+	st.session.Store(newSession(ctx))
+	for _, opt := range opts {
+		opt(st)
+	}
+	st.history.buf = make([]time.Time, st.historySize)
+	if st.offset != 0 {
+		st.clock = offsetClock{st.clock, st.offset}
+	}
+	st.created = st.clock.Now()
+	return st
+}
+
+// session is a single run of a ticker from its start until it is stopped.
+// A Reset of a stopped ticker starts a new session.
+type session struct {
+	ctx    context.Context // Done once the session is stopped.
+	stop   context.CancelFunc
+	exited chan struct{} // Closed once the goroutine of the session exited, nil without one.
+}
+
+func newSession(parent context.Context) *session {
+	s := &session{}
+	s.ctx, s.stop = context.WithCancel(parent)
+	return s
+}
+
+// ctx returns the context of the current session of st, which is done once st is stopped.
+func (st *ScheduledTicker) ctx() context.Context {
+	return st.session.Load().ctx
+}
+
+// revive starts a new session of st if it is stopped so that a Reset re-arms it. It reports whether
+// it did, in which case the loop of st has to be launched anew. st.mu must be held.
+func (st *ScheduledTicker) revive() bool {
+	if st.ctx().Err() == nil || st.final || st.parent.Err() != nil {
+		return false
+	}
+	s := newSession(st.parent)
+	if !st.manual && st.pool == nil {
+		// NOTE: create it before the session is visible to Done.
+		s.exited = make(chan struct{})
+	}
+	st.session.Store(s)
+	st.seqStart = st.seq
+	return true
+}
+
+// start launches the loop of st and schedules the first tick.
+// The schedule is set up before the loop starts so that the loop never sees a ticker without one.
+func (st *ScheduledTicker) start(first time.Time, interval time.Duration) {
+	st.mu.Lock()
+	st.setSchedule(st.clock.Now(), first, interval, st.missed)
+	st.mu.Unlock()
+	st.launch()
+}
+
+// startSchedule launches the loop of st ticking according to s, or stops st right away if s has no point
+// in time in the future. interval is the nominal interval of s used where a single interval is needed,
+// e.g. for jitter.
+func (st *ScheduledTicker) startSchedule(s schedule, interval time.Duration) {
+	st.mu.Lock()
+	st.setCustomSchedule(st.clock.Now(), s, interval)
+	// NOTE: the next tick is also missing while paused, e.g. for lack of subscribers, so ask the schedule.
+	over := st.first.IsZero()
+	st.mu.Unlock()
+	if over {
+		st.Stop()
+		return
+	}
+	st.launch()
+}
+
+// launch runs the loop of st in a goroutine of its own or adds st to its pool.
+func (st *ScheduledTicker) launch() {
+	if st.pool != nil {
+		st.pool.update(st)
+		return
+	}
+	s := st.session.Load()
+	if s.exited == nil {
+		s.exited = make(chan struct{})
+	}
+	active.Add(1)
+	go st.run(s)
+}
+
+// setCustomSchedule replaces the schedule of st at now by s with the nominal interval. st.mu must be held.
+func (st *ScheduledTicker) setCustomSchedule(now time.Time, s schedule, interval time.Duration) {
+	st.sched = s
+	st.first = s.next(now)
+	st.interval = interval
+	st.swap = nil
+	st.pausedAt = time.Time{}
+	st.lapsed = 0
+	st.restart(now, st.missed)
+}
+
 // Reset stops a ticker and resets its period to the specified duration.
 // The next tick will arrive at time next and then occur regularly at the new period.
 // If time next is in the past it will tick at the matching interval started from that point in the past.
+// Whether the most recent of those ticks fires immediately is determined by the [MissedTickPolicy]
+// of the ticker; use ResetWithPolicy to choose per call. If next is the zero time the new schedule starts now and the next tick arrives after one interval.
+// Like for a [time.Timer] a Reset re-arms a stopped ticker, unless the context it was created with is done or
+// it cannot be restarted because it was created by NewToSink, NewFunc or FromTimeTicker or with WithCloseOnStop.
+// This applies to all variants of Reset.
 func (st *ScheduledTicker) Reset(next time.Time, interval time.Duration) {
+	if st == nil {
+		return
+	}
 	if interval <= 0 {
 		panic(errors.New("non-positive interval for ScheduledTicker.Reset"))
 	}
+	st.applyReset(time.Time{}, next, interval, false, st.missed)
+}
+
+// TryReset is like Reset but returns an error instead of panicking if interval is not greater than zero,
+// and ErrFarFuture if next lies further in the future than allowed by WithMaxFutureStart.
+// The ticker is left unchanged if an error is returned.
+func (st *ScheduledTicker) TryReset(next time.Time, interval time.Duration) error {
+	if st == nil {
+		return nil
+	}
+	if interval <= 0 {
+		return errors.New("non-positive interval for ScheduledTicker.TryReset")
+	}
+	if err := st.checkFutureStart(next); err != nil {
+		return err
+	}
+	st.applyReset(time.Time{}, next, interval, false, st.missed)
+	return nil
+}
+
+// checkFutureStart returns ErrFarFuture if first lies further in the future than allowed by WithMaxFutureStart.
+func (st *ScheduledTicker) checkFutureStart(first time.Time) error {
+	if st.maxFuture <= 0 {
+		return nil
+	}
+	if ahead := first.Sub(st.clock.Now()); ahead > st.maxFuture {
+		return fmt.Errorf("%w: %v is %v ahead, more than %v", ErrFarFuture, first, ahead, st.maxFuture)
+	}
+	return nil
+}
+
+// ResetAt is like Reset but calculates the new schedule as if the current time was now,
+// e.g. to deterministically test the outcome of a reset. A zero next means now.
+// The ticker still fires according to the actual current time, so a tick that is due
+// relative to the actual time but not yet relative to now fires immediately.
+// The duration interval must be greater than zero; if not, ResetAt will panic.
+func (st *ScheduledTicker) ResetAt(now, next time.Time, interval time.Duration) {
+	if st == nil {
+		return
+	}
+	if interval <= 0 {
+		panic(errors.New("non-positive interval for ScheduledTicker.ResetAt"))
+	}
+	if now.IsZero() {
+		now = st.clock.Now()
+	}
+	st.applyReset(now, next, interval, false, st.missed)
+}
+
+// ResetPriority is like Reset but takes precedence over concurrent calls to Reset: a Reset that was
+// called before ResetPriority but did not finish yet has no effect once ResetPriority took effect.
+// Calls to Reset made after ResetPriority returned apply as usual.
+// The duration interval must be greater than zero; if not, ResetPriority will panic.
+func (st *ScheduledTicker) ResetPriority(next time.Time, interval time.Duration) {
+	if st == nil {
+		return
+	}
+	if interval <= 0 {
+		panic(errors.New("non-positive interval for ScheduledTicker.ResetPriority"))
+	}
+	st.applyReset(time.Time{}, next, interval, true, st.missed)
+}
+
+// ResetProportional changes the interval of the ticker keeping the progress through the current period.
+// The time remaining until the next tick is scaled by the ratio of the new to the old interval, e.g. halfway
+// through a 10s period a change to 20s makes the next tick arrive in 10s, and from then on the ticks
+// occur regularly at the new interval. This smooths changes of the cadence compared to Reset.
+// If no tick is pending, e.g. while the ticker is paused, the new schedule starts now and the next tick
+// arrives after one interval.
+// The duration interval must be greater than zero; if not, ResetProportional will panic.
+func (st *ScheduledTicker) ResetProportional(interval time.Duration) {
+	if st == nil {
+		return
+	}
+	if interval <= 0 {
+		panic(errors.New("non-positive interval for ScheduledTicker.ResetProportional"))
+	}
+	st.mu.Lock()
+	now := st.clock.Now()
+	next := time.Time{}
+	if !st.next.IsZero() && st.interval > 0 {
+		remaining := st.next.Sub(now)
+		if remaining < 0 {
+			remaining = 0
+		}
+		next = now.Add(time.Duration(float64(remaining) / float64(st.interval) * float64(interval)))
+	}
+	st.mu.Unlock()
+	st.applyReset(now, next, interval, false, st.missed)
+}
+
+// testHookReset is called between starting and applying a reset if set.
+var testHookReset func(priority bool)
+
+// applyReset resets the schedule at now, or the current time if now is zero, handling missed ticks
+// according to policy unless a priority reset that was started later already took effect.
+func (st *ScheduledTicker) applyReset(now, next time.Time, interval time.Duration, priority bool, policy MissedTickPolicy) {
+	ticket := st.resets.Add(1)
+	if testHookReset != nil {
+		testHookReset(priority)
+	}
+	st.mu.Lock()
+	if ticket < st.priorityReset {
+		st.mu.Unlock()
+		return
+	}
+	if priority {
+		st.priorityReset = ticket
+	}
+	if now.IsZero() {
+		now = st.clock.Now()
+	}
+	revived := st.revive()
+	old := Config{FirstStart: st.first, Interval: st.interval}
+	st.setSchedule(now, next, interval, policy)
+	st.reconfig++
+	current := Config{FirstStart: st.first, Interval: st.interval}
+	st.mu.Unlock()
+	if revived && !st.manual {
+		st.launch()
+	} else {
+		st.notify()
+	}
+	if st.onReconfig != nil {
+		st.onReconfig(old, current)
+	}
+}
+
+// setSchedule replaces the schedule of st at now by the one starting at next re-occurring at interval
+// handling missed ticks according to policy. st.mu must be held.
+func (st *ScheduledTicker) setSchedule(now, next time.Time, interval time.Duration, policy MissedTickPolicy) {
+	if next.IsZero() {
+		next = now
+	}
+	st.sched = nil
+	st.first = next
 	st.interval = interval
-	st.reset <- next
+	st.swap = nil
+	// Boundaries of the old schedule missed while paused are not caught up on.
+	st.pausedAt = time.Time{}
+	st.lapsed = 0
+	st.restart(now, policy)
+	if st.grace > 0 && !st.next.IsZero() && !now.Before(next) && now.Sub(next) <= st.grace {
+		// The first tick is only late by scheduling latency, so fire it instead of skipping it.
+		st.setNext(next)
+	}
+}
+
+// Swap changes the schedule of the ticker without a gap or an overlap like Reset could cause.
+// The current schedule keeps ticking up to but excluding the first tick of the new schedule,
+// which is the tick at time first or, if first is in the past, the next tick of the new schedule
+// in the future. From then on the ticker ticks according to the new schedule. A zero first is
+// treated as now. A later Reset or Swap replaces a pending Swap.
+// The duration interval must be greater than zero; if not, Swap will panic.
+func (st *ScheduledTicker) Swap(first time.Time, interval time.Duration) {
+	if st == nil {
+		return
+	}
+	if interval <= 0 {
+		panic(errors.New("non-positive interval for ScheduledTicker.Swap"))
+	}
+	st.setSwap(first, interval)
+	st.notify()
+}
+
+// setSwap schedules the switch to the schedule starting at first re-occurring at interval.
+// It releases st.mu even if the current schedule panics.
+func (st *ScheduledTicker) setSwap(first time.Time, interval time.Duration) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	now := st.clock.Now()
+	if first.IsZero() {
+		first = now
+	}
+	st.swap = &Config{FirstStart: first, Interval: interval}
+	st.swapAt = NextRun(first, interval, now)
+	if !st.next.IsZero() && !st.next.Before(st.swapAt) {
+		st.reschedule(now)
+	}
+}
+
+// notify tells the loop that the schedule changed without waiting for it.
+func (st *ScheduledTicker) notify() {
+	if st.pool != nil {
+		st.pool.update(st)
+		return
+	}
+	if st.manual {
+		return
+	}
+	// NOTE: the loop reads the schedule anew after every wake-up, so a pending one covers this change as well.
+	select {
+	case st.reset <- struct{}{}:
+	default:
+	}
+}
+
+// ConfigChanged reports whether c differs from the schedule the ticker is currently running.
+// It can be used to skip a Reset, and with it a disruption of the current phase, if nothing changed.
+func (st *ScheduledTicker) ConfigChanged(c Config) bool {
+	if st == nil {
+		return false
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return !c.Equal(Config{FirstStart: st.first, Interval: st.interval})
+}
+
+// LastBoundary returns the most recent point in time of the schedule at or before now, i.e. the
+// start of the interval the ticker is currently in. It returns the zero time if the schedule has not started yet.
+func (st *ScheduledTicker) LastBoundary() time.Time {
+	if st == nil {
+		return time.Time{}
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return st.boundaryAt(st.clock.Now())
+}
+
+// boundaryAt returns the most recent point in time of the schedule at or before now or the zero time
+// if there is none or the schedule cannot look back. st.mu must be held.
+func (st *ScheduledTicker) boundaryAt(now time.Time) time.Time {
+	if st.sched != nil {
+		if r, ok := st.sched.(reversibleSchedule); ok {
+			return r.prev(now)
+		}
+		return time.Time{}
+	}
+	return PreviousRun(st.first, st.interval, now)
+}
+
+// IntervalsElapsed returns the number of whole intervals that elapsed since the first start of the schedule,
+// which equals [Tick.Index] of a tick delivered at its scheduled time. The first start is the one given to
+// the constructor or to the last Reset. It returns 0 before the first start and for schedules that
+// are not defined by a first start and an interval, like those of NewCron.
+func (st *ScheduledTicker) IntervalsElapsed() uint64 {
+	if st == nil {
+		return 0
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if st.sched != nil {
+		return 0
+	}
+	return intervalsBetween(st.first, st.clock.Now(), st.interval)
+}
+
+// intervalsBetween returns the number of whole intervals from first to t, or 0 if t is before first.
+func intervalsBetween(first, t time.Time, interval time.Duration) uint64 {
+	if interval <= 0 || t.Before(first) {
+		return 0
+	}
+	return uint64(t.Sub(first) / interval)
+}
+
+// NextTick returns the point in time the next tick fires at, e.g. to show the next run on a dashboard
+// or in a health endpoint. With [WithJitterFraction] this includes the random offset of the tick, so it
+// might differ from the point in time of the schedule. It returns the zero time if no tick is scheduled,
+// e.g. while the ticker is paused, after its schedule ended or once it is stopped.
+func (st *ScheduledTicker) NextTick() time.Time {
+	if st == nil || st.Stopped() {
+		return time.Time{}
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return st.fireAt
+}
+
+// Until returns the duration until the next tick as reported by NextTick. It returns zero if no tick is
+// scheduled. It is negative if the next tick is overdue, e.g. while the ticker waits for a receiver of C.
+func (st *ScheduledTicker) Until() time.Duration {
+	if st == nil {
+		return 0
+	}
+	next := st.NextTick()
+	if next.IsZero() {
+		return 0
+	}
+	return next.Sub(st.clock.Now())
 }
 
 // Stop turns off a ticker. After Stop, no more ticks will be sent.
 // Stop does not close the channel, to prevent a concurrent goroutine
-// reading from the channel from seeing an erroneous "tick".
+// reading from the channel from seeing an erroneous "tick", unless the
+// ticker was created with WithCloseOnStop.
+// Stop may be called multiple times and concurrently. Calling Reset
+// after Stop re-arms the ticker as described there. Stop does not wait for
+// the goroutine of the ticker to exit; use Done for that.
 func (st *ScheduledTicker) Stop() {
-	close(st.stop)
-	close(st.reset)
+	if st == nil {
+		return
+	}
+	st.session.Load().stop()
+	if st.pool != nil {
+		st.pool.update(st)
+	}
 }
 
-func (st *ScheduledTicker) loop() {
-	var nextTick <-chan time.Time
-	var ticker *time.Ticker
-	var resetTimer *time.Timer
+// Stopped reports whether the ticker is stopped, either by Stop or because the context
+// it was created with is done. It stays true unless a Reset re-arms the ticker as described there, after
+// which it reports false again. A nil ticker is always stopped.
+func (st *ScheduledTicker) Stopped() bool {
+	if st == nil {
+		return true
+	}
+	return st.ctx().Err() != nil
+}
 
-	nextTickUpdated := make(chan struct{})
+func (st *ScheduledTicker) loop(s *session) {
+	timer := st.clock.NewTimer(time.Hour)
+	st.timers.Add(1)
+	stopTimer(timer)
 	defer func() {
-		var ntu chan struct{}
-		ntu, nextTickUpdated = nextTickUpdated, nil
-		close(ntu)
+		timer.Stop()
+		st.timers.Add(-1)
 	}()
 
-	stopTickerTimer := func() {
-		nextTick = nil
-		if resetTimer != nil {
-			resetTimer.Stop()
-			resetTimer = nil
+	// NOTE: timerC stays nil while nothing is scheduled so that select never picks it.
+	var timerC <-chan time.Time
+	// The tick the timer is armed for and when it is due including jitter.
+	var armed, due time.Time
+	// The heartbeat the timer is armed for if it is due before the next tick.
+	var beat time.Time
+	// When the last tick or heartbeat fired.
+	last := st.clock.Now()
+	ready := st.ready
+	gate := st.gate
+	for {
+		stopTimer(timer)
+		timerC = nil
+		st.mu.Lock()
+		armed, due = st.next, st.fireAt
+		st.mu.Unlock()
+		var wake time.Time
+		if ready == nil && !armed.IsZero() {
+			wake = due
 		}
-		if ticker != nil {
-			ticker.Stop()
-			ticker = nil
+		beat = time.Time{}
+		if st.heartbeat > 0 {
+			// Ticks take precedence over heartbeats.
+			if b := last.Add(st.heartbeat); wake.IsZero() || b.Before(wake) {
+				beat, wake = b, b
+			}
 		}
-	}
-	defer stopTickerTimer()
-	for {
+		if !wake.IsZero() {
+			wait := wake.Sub(st.clock.Now())
+			if st.maxDelay > 0 && wait > st.maxDelay {
+				wait = st.maxDelay
+			}
+			timer.Reset(wait)
+			timerC = timer.C()
+		}
+		if st.armed != nil {
+			close(st.armed)
+			st.armed = nil
+		}
+
 		select {
-		case <-st.stop:
+		case <-s.ctx.Done():
+			st.traceEvent("stopped")
+			st.linger(timer)
 			return
-		case nextStart := <-st.reset:
-			stopTickerTimer()
-			resetTimer = time.AfterFunc(time.Until(nextRun(nextStart, st.interval)), func() {
-				select {
-				case <-st.stop:
-					return
-				default:
-				}
-				sendTime(st.ticks, time.Now())
-				ticker = time.NewTicker(st.interval)
-				nextTick = ticker.C
-				if nextTickUpdated != nil {
-					nextTickUpdated <- struct{}{}
-				}
-			})
-		case <-nextTickUpdated:
-		// NOTE: this case seems unnecessary but is required to have select reevaluate the reference to channel nextTick
-		// that was changed as part of calling Reset.
-
-		case t := <-nextTick:
-			sendTime(st.ticks, t)
+		case <-st.reset:
+			st.traceEvent("reset-received")
+		case <-ready:
+			st.traceEvent("ready")
+			ready = nil
+			now := st.clock.Now()
+			st.mu.Lock()
+			if !st.next.IsZero() {
+				st.setNext(now)
+			}
+			st.mu.Unlock()
+			if st.tick(now, now) {
+				last = now
+			}
+		case open, ok := <-gate:
+			st.traceEvent("gate")
+			if !ok {
+				// A closed gate stays disabled.
+				gate = nil
+			}
+			st.mu.Lock()
+			st.gateOpen = open
+			st.mu.Unlock()
+		case <-timerC:
+			st.traceEvent("timer-fired")
+			now := st.clock.Now()
+			if !now.Before(due) && st.tick(now, armed) {
+				last = now
+			} else if !beat.IsZero() && !now.Before(beat) {
+				st.traceEvent("heartbeat")
+				st.heartbeatAt(now, beat)
+				last = now
+			}
+		}
+	}
+}
+
+// jitter returns a random offset for the tick at next of a schedule with the given interval.
+func (st *ScheduledTicker) jitter(next time.Time, interval time.Duration) time.Duration {
+	if st.manual {
+		return 0
+	}
+	max := st.jitterFraction*float64(interval) + float64(st.decayingJitter(next))
+	if max == 0 {
+		return 0
+	}
+	return time.Duration((2*rand.Float64() - 1) * max)
+}
+
+// restart calculates the first tick of a new schedule handling missed ticks according to policy. st.mu must be held.
+func (st *ScheduledTicker) restart(now time.Time, policy MissedTickPolicy) {
+	st.backfill = policy == BackfillMissed
+	st.reschedule(now)
+	if st.sched != nil || st.next.IsZero() || now.Before(st.first) {
+		return
+	}
+	switch policy {
+	case FireMissed:
+		// Schedule the most recent missed tick which is due immediately.
+		st.setNext(PreviousRun(st.first, st.interval, now))
+	case BackfillMissed:
+		// Schedule the first missed tick; tick schedules the others one after the other.
+		st.setNext(st.first)
+	}
+}
+
+// reschedule calculates the next tick after now. It leaves the ticker
+// without a next tick while it is paused. st.mu must be held.
+func (st *ScheduledTicker) reschedule(now time.Time) {
+	if st.isPaused() {
+		if st.pausedAt.IsZero() {
+			st.pausedAt = now
+		}
+		st.setNext(time.Time{})
+		return
+	}
+	next := st.nextAfter(now)
+	if st.swap != nil && !next.Before(st.swapAt) {
+		st.sched = nil
+		st.first, st.interval = st.swap.FirstStart, st.swap.Interval
+		st.swap = nil
+		next = NextRun(st.first, st.interval, now)
+	}
+	if !st.pausedAt.IsZero() {
+		// The ticker resumes.
+		if missed := st.nextAfter(st.pausedAt); st.catchUp && !missed.IsZero() && !missed.After(now) {
+			// Catch up on the most recent boundary missed while paused immediately.
+			if last := st.boundaryAt(now); !last.IsZero() {
+				missed = last
+			}
+			next = missed
+		}
+		st.pausedAt = time.Time{}
+	}
+	st.setNext(next)
+}
+
+// isPaused reports whether the ticker is paused, either explicitly or for lack of subscribers. st.mu must be held.
+func (st *ScheduledTicker) isPaused() bool {
+	return st.paused || st.autoPause && len(st.subs) == 0
+}
+
+// setNext schedules the next tick at next and determines when it fires including jitter. st.mu must be held.
+func (st *ScheduledTicker) setNext(next time.Time) {
+	st.next = next
+	st.fireAt = next
+	if !next.IsZero() {
+		st.fireAt = next.Add(st.jitter(next, st.interval))
+	}
+}
+
+// nextAfter returns the next point in time of the schedule after t. st.mu must be held.
+func (st *ScheduledTicker) nextAfter(t time.Time) time.Time {
+	if st.sched != nil {
+		return st.sched.next(t)
+	}
+	return NextRun(st.first, st.interval, t)
+}
+
+// tick fires the tick scheduled at scheduled and schedules the one after it.
+// It does nothing if the schedule changed in the meantime. It reports whether the tick was fired,
+// which it is not if the predicate skips it.
+func (st *ScheduledTicker) tick(now, scheduled time.Time) bool {
+	// NOTE: call the predicate before locking since it might use the ticker itself.
+	skip := st.predicate != nil && !st.predicate(scheduled)
+	p, outcome := st.take(now, scheduled, skip)
+	switch outcome {
+	case tickStale:
+		return false
+	case tickSkipped:
+		st.traceEvent("tick-skipped")
+		return false
+	case tickEnded:
+		st.Stop()
+		return false
+	case tickDryRun:
+		if st.dryRunLog != nil {
+			st.dryRunLog(scheduled)
+		}
+		return false
+	}
+	st.fire(p.Tick, p.subs, p.wait)
+	if p.last {
+		st.Stop()
+	}
+	return true
+}
+
+// tickOutcome is what becomes of a tick taken from the schedule.
+type tickOutcome int
+
+const (
+	tickFired   tickOutcome = iota
+	tickStale               // The schedule changed in the meantime.
+	tickSkipped             // Skipped by the predicate or the gate.
+	tickEnded               // The schedule ended before the tick.
+	tickDryRun              // Logged instead of fired.
+)
+
+// pendingTick is a tick taken from the schedule that is about to be fired.
+type pendingTick struct {
+	Tick
+	subs []*subscriber
+	wait bool // Whether to wait for the receiver.
+	last bool // Whether the schedule is over after the tick.
+}
+
+// take takes the tick scheduled at scheduled that fires at now from the schedule and schedules the one
+// after it, unless the outcome is that the tick is stale or the schedule ended. It locks st.mu and
+// releases it even if the schedule panics, e.g. a Schedule of NewFromSchedule, so that a ticker
+// recovered by WithSupervisor keeps working.
+func (st *ScheduledTicker) take(now, scheduled time.Time, skip bool) (pendingTick, tickOutcome) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if st.next.IsZero() || !st.next.Equal(scheduled) {
+		return pendingTick{}, tickStale
+	}
+	if skip || st.gate != nil && !st.manual && !st.gateOpen {
+		st.advance(now, scheduled)
+		st.stats.Skipped++
+		return pendingTick{}, tickSkipped
+	}
+	if st.pastUntil(scheduled) {
+		return pendingTick{}, tickEnded
+	}
+	if st.dryRun {
+		st.advance(now, scheduled)
+		return pendingTick{}, tickDryRun
+	}
+	st.seq++
+	t := Tick{
+		Seq:       st.seq,
+		Scheduled: st.next,
+		Actual:    now,
+		Dropped:   st.dropped,
+		Missed:    st.lapsed,
+		Late:      scheduled.Before(st.created),
+	}
+	if st.sched == nil {
+		t.Index = intervalsBetween(st.first, scheduled, st.interval)
+		t.Phase = int(t.Index % 2)
+	} else {
+		// Without an index the phase can only alternate from tick to tick.
+		t.Phase = int((st.seq - 1) % 2)
+	}
+	if st.windowStart {
+		t.window = st.windowOf(scheduled)
+	}
+	if st.loc != nil {
+		t.Scheduled, t.Actual, t.window = t.Scheduled.In(st.loc), t.Actual.In(st.loc), t.window.In(st.loc)
+	}
+	st.lapsed = 0
+	p := pendingTick{Tick: t, subs: st.subs}
+	p.wait = t.Seq <= st.guaranteed || st.backfill && scheduled.Before(now)
+	st.advance(now, scheduled)
+	// A finite schedule that has no next tick although not paused is over.
+	p.last = st.sched != nil && st.next.IsZero() && !st.isPaused() ||
+		st.maxTicks > 0 && st.seq-st.seqStart >= st.maxTicks || st.pastUntil(st.next)
+	return p, tickFired
+}
+
+// advance schedules the tick after the one at scheduled that fires at now. Ticks missed by now are
+// skipped and counted for Tick.Missed unless they are backfilled. st.mu must be held.
+func (st *ScheduledTicker) advance(now, scheduled time.Time) {
+	if st.backfill {
+		st.reschedule(scheduled)
+		return
+	}
+	// A jittered tick might fire early, so never schedule the same tick twice.
+	st.reschedule(laterOf(now, scheduled))
+	if st.sched == nil {
+		st.lapsed += intervalsBetween(scheduled, now, st.interval)
+		return
+	}
+	for p := st.sched.next(scheduled); !p.IsZero() && !p.After(now); p = st.sched.next(p) {
+		st.lapsed++
+	}
+}
+
+// fire delivers t on C, waiting for the receiver if wait is set or the ticker is blocking, and to subs.
+func (st *ScheduledTicker) fire(t Tick, subs []*subscriber, wait bool) {
+	held := st.holdForAck(t)
+	delivered := !held && st.deliver(t, wait || st.blocking)
+	for _, sub := range subs {
+		sub.send(t)
+	}
+
+	st.mu.Lock()
+	st.history.add(t.Actual)
+	if !held {
+		st.account(t, delivered)
+	}
+	st.mu.Unlock()
+
+	if st.trace != nil {
+		switch {
+		case held:
+			st.trace("tick-held")
+		case delivered:
+			st.trace("tick-delivered")
+		default:
+			st.trace("tick-dropped")
+		}
+	}
+}
+
+// account records whether t was delivered on C. st.mu must be held.
+func (st *ScheduledTicker) account(t Tick, delivered bool) {
+	if delivered {
+		st.dropped = 0
+		st.stats.Delivered++
+		st.recordLatency(t)
+		st.delivery = st.delivery.complete(t.stamp())
+	} else {
+		st.dropped++
+		st.stats.Dropped++
+		if st.ackLimit > 0 {
+			// Release the slot reserved by holdForAck.
+			st.inflight--
+		}
+	}
+}
+
+// stopTimer stops t and drains its channel so that it can safely be reset.
+func stopTimer(t Timer) {
+	if !t.Stop() {
+		select {
+		case <-t.C():
+		default:
 		}
 	}
 }
 
-func sendTime(ticks chan<- time.Time, tick time.Time) {
+// laterOf returns the later of a and b.
+func laterOf(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return b
+	}
+	return a
+}
+
+// sendWait delivers v on c waiting for the receiver if c is full until ctx is done.
+// It reports whether v was delivered.
+func sendWait[T any](ctx context.Context, c chan T, v T) bool {
+	select {
+	case c <- v:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// send delivers v on c unless c is full in which case v is dropped.
+// If newest is set, the oldest value in c is dropped in favor of v instead.
+// It reports whether v was delivered.
+func send[T any](c chan T, v T, newest bool) bool {
+	select {
+	case c <- v:
+		return true
+	default:
+	}
+	if !newest {
+		return false
+	}
+	select {
+	case <-c:
+	default:
+	}
 	select {
-	case ticks <- tick:
+	case c <- v:
+		return true
 	default:
+		return false
 	}
 }
 
-// nextRun calculates the next point in time starting from firstStart re-occurring at interval.
-func nextRun(firstStart time.Time, interval time.Duration) time.Time {
+// maxDuration is the largest representable time.Duration.
+// [time.Time.Sub] saturates at this value.
+const maxDuration time.Duration = 1<<63 - 1
+
+// NextRun calculates the next point in time after now of the schedule starting at firstStart re-occurring at interval.
+// If firstStart lies after now it is the next run itself. A non-positive interval has no next run other than now itself.
+func NextRun(firstStart time.Time, interval time.Duration, now time.Time) time.Time {
 	// Simple case: we start first time in the future
-	if time.Now().UTC().Before(firstStart) {
+	if now.Before(firstStart) {
 		return firstStart
 	}
-	// Now we have to calculate the next run in interval since first start
-	pastIterations := time.Since(firstStart) / interval
-	return firstStart.Add((pastIterations + 1) * interval)
+	// Guard against dividing by zero below
+	if interval <= 0 {
+		return now
+	}
+	return PreviousRun(firstStart, interval, now).Add(interval)
+}
+
+// PreviousRun calculates the last point in time at or before now of the schedule starting at firstStart re-occurring at interval.
+// This is the start of the interval now lies in. If firstStart lies after now there is no previous run and PreviousRun
+// returns the zero time. A non-positive interval has no previous run other than now itself.
+func PreviousRun(firstStart time.Time, interval time.Duration, now time.Time) time.Time {
+	if now.Before(firstStart) {
+		return time.Time{}
+	}
+	if interval <= 0 {
+		return now
+	}
+	// Now we have to calculate the last run in interval since first start
+	elapsed := now.Sub(firstStart)
+	for elapsed == maxDuration {
+		// The distance does not fit into a time.Duration, so move the start closer
+		// by the largest multiple of interval that does.
+		firstStart = firstStart.Add(maxDuration / interval * interval)
+		elapsed = now.Sub(firstStart)
+	}
+	pastIterations := elapsed / interval
+	return firstStart.Add(pastIterations * interval)
 }
@@ -1,8 +1,13 @@
 package sticker
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"math/big"
 	"runtime"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -153,6 +158,47 @@ func TestDropOnSlowClient(t *testing.T) {
 	}
 }
 
+// startingClock is a fakeClock whose first timer is only created once released,
+// which holds the loop of a ticker in the middle of its start-up.
+type startingClock struct {
+	*fakeClock
+	starting chan struct{} // Closed once the loop asks for its timer.
+	release  chan struct{}
+	once     sync.Once
+}
+
+func (c *startingClock) NewTimer(d time.Duration) Timer {
+	c.once.Do(func() {
+		close(c.starting)
+		<-c.release
+	})
+	return c.fakeClock.NewTimer(d)
+}
+
+func TestResetRacingStart(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	user := Config{FirstStart: first.Add(time.Hour), Interval: time.Minute}
+	fc := &startingClock{
+		fakeClock: newFakeClock(first.Add(-time.Second)),
+		starting:  make(chan struct{}),
+		release:   make(chan struct{}),
+	}
+	st := New(first, time.Second, WithClock(fc))
+	defer st.Stop()
+
+	// Reset while the loop is provably starting and has not read the schedule yet.
+	receive(t, fc.starting)
+	st.Reset(user.FirstStart, user.Interval)
+	close(fc.release)
+
+	if st.ConfigChanged(user) {
+		t.Fatalf("expected schedule %+v after Reset", user)
+	}
+	fc.expectTimer(t, user.FirstStart)
+	fc.Set(first)
+	expectNothing(t, st.C)
+}
+
 func TestNextRun(t *testing.T) {
 	cases := []struct {
 		name       string
@@ -174,22 +220,829 @@ func TestNextRun(t *testing.T) {
 				Add(15 * (30 * time.Second)). // NOTE: this is basically to force Round below into a Ceil
 				Round(15 * time.Minute),
 		},
-		// NOTE: the following test is hard to calculate a rolling-result correctly
-		// {
-		// 	"odd",
-		// 	time.Date(2021, 11, 30, 14, 48, 0, 0, time.UTC),
-		// 	17 * time.Hour,
-		// 	time.Date(2021, 11, 30, 14, 48, 0, 0, time.UTC).Add(17 * time.Hour),
-		// },
+		{
+			name:       "distantPast",
+			firstStart: time.Time{},
+			interval:   time.Second,
+			expected:   time.Now().Truncate(time.Second).Add(time.Second),
+		},
+		// NOTE: rolling results of odd intervals relative to the current time are covered by FuzzNextRun
 	}
 
 	for _, tc := range cases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			firstRun := nextRun(tc.firstStart, tc.interval)
+			firstRun := NextRun(tc.firstStart, tc.interval, time.Now())
 			if !firstRun.Equal(tc.expected) {
 				t.Errorf("expected %v, but got %v", tc.expected, firstRun)
 			}
 		})
 	}
 }
+
+func FuzzNextRun(f *testing.F) {
+	f.Add(time.Date(2021, 11, 30, 14, 48, 0, 0, time.UTC).Unix(), int64(0), int64(17*time.Hour), time.Date(2021, 11, 30, 14, 48, 0, 0, time.UTC).Unix(), int64(0))
+	f.Add(time.Date(2021, 11, 30, 14, 48, 0, 0, time.UTC).Unix(), int64(0), int64(17*time.Hour), time.Date(2021, 12, 1, 7, 48, 0, 0, time.UTC).Unix(), int64(1))
+	f.Add(time.Time{}.Unix(), int64(0), int64(time.Second), time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC).Unix(), int64(5))
+	f.Add(time.Time{}.Unix(), int64(0), int64(7), time.Date(2500, 1, 1, 0, 0, 0, 0, time.UTC).Unix(), int64(999999999))
+	f.Add(int64(0), int64(0), int64(time.Minute), int64(-1), int64(0))
+
+	// Keep the times within a range of roughly ±35000 years around the Unix epoch that time.Unix can represent.
+	const maxSeconds = 1 << 40
+	f.Fuzz(func(t *testing.T, firstSec, firstNsec, intervalNsec, nowSec, nowNsec int64) {
+		interval := time.Duration(intervalNsec)
+		if interval <= 0 {
+			t.Skip("non-positive intervals have no schedule")
+		}
+		firstStart := time.Unix(firstSec%maxSeconds, firstNsec)
+		now := time.Unix(nowSec%maxSeconds, nowNsec)
+		next := NextRun(firstStart, interval, now)
+
+		if now.Before(firstStart) {
+			if !next.Equal(firstStart) {
+				t.Fatalf("NextRun(%v, %v, %v) = %v, expected first start", firstStart, interval, now, next)
+			}
+			return
+		}
+		// Compare in nanoseconds with arbitrary precision since the distances may exceed time.Duration.
+		nanos := func(t time.Time) *big.Int {
+			n := big.NewInt(t.Unix())
+			n.Mul(n, big.NewInt(int64(time.Second)))
+			return n.Add(n, big.NewInt(int64(t.Nanosecond())))
+		}
+		iv := big.NewInt(intervalNsec)
+		if !next.After(now) {
+			t.Fatalf("NextRun(%v, %v, %v) = %v, expected after now", firstStart, interval, now, next)
+		}
+		if m := new(big.Int).Mod(new(big.Int).Sub(nanos(next), nanos(firstStart)), iv); m.Sign() != 0 {
+			t.Fatalf("NextRun(%v, %v, %v) = %v, expected a multiple of interval from first start but off by %v", firstStart, interval, now, next, m)
+		}
+		if prev := new(big.Int).Sub(nanos(next), iv); prev.Cmp(nanos(now)) > 0 {
+			t.Fatalf("NextRun(%v, %v, %v) = %v, expected the earliest run after now", firstStart, interval, now, next)
+		}
+	})
+}
+
+func TestConfigChanged(t *testing.T) {
+	first := time.Date(2345, 1, 1, 0, 0, 0, 0, time.UTC)
+	st := New(first, time.Minute)
+	defer st.Stop()
+
+	cases := []struct {
+		name    string
+		config  Config
+		changed bool
+	}{
+		{
+			name:    "equal",
+			config:  Config{FirstStart: first, Interval: time.Minute},
+			changed: false,
+		},
+		{
+			name:    "equalOtherLocation",
+			config:  Config{FirstStart: first.In(time.FixedZone("UTC+2", 2*60*60)), Interval: time.Minute},
+			changed: false,
+		},
+		{
+			name:    "intervalOnly",
+			config:  Config{FirstStart: first, Interval: time.Hour},
+			changed: true,
+		},
+		{
+			name:    "startOnly",
+			config:  Config{FirstStart: first.Add(time.Second), Interval: time.Minute},
+			changed: true,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if changed := st.ConfigChanged(tc.config); changed != tc.changed {
+				t.Errorf("expected %v, but got %v", tc.changed, changed)
+			}
+		})
+	}
+}
+
+func TestNextRunEdgeCaseIntervals(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+	cases := []struct {
+		name       string
+		firstStart time.Time
+		interval   time.Duration
+		expected   time.Time
+	}{
+		{
+			name:       "zeroPast",
+			firstStart: past,
+			interval:   0,
+			expected:   now,
+		},
+		{
+			name:       "negativePast",
+			firstStart: past,
+			interval:   -time.Second,
+			expected:   now,
+		},
+		{
+			name:       "zeroFuture",
+			firstStart: future,
+			interval:   0,
+			expected:   future,
+		},
+		{
+			name:       "nanosecond",
+			firstStart: past,
+			interval:   time.Nanosecond,
+			expected:   now.Add(time.Nanosecond),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if next := NextRun(tc.firstStart, tc.interval, now); !next.Equal(tc.expected) {
+				t.Errorf("expected %v, but got %v", tc.expected, next)
+			}
+		})
+	}
+}
+
+func TestNextRunNonDividingIntervals(t *testing.T) {
+	firstStart := time.Date(2023, 6, 1, 9, 13, 0, 0, time.UTC)
+	cases := []struct {
+		name     string
+		interval time.Duration
+		now      time.Time
+		expected time.Time
+	}{
+		{
+			// 167 minutes elapsed, 23 full intervals end at 11:54.
+			name:     "sevenMinutes",
+			interval: 7 * time.Minute,
+			now:      time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC),
+			expected: time.Date(2023, 6, 1, 12, 1, 0, 0, time.UTC),
+		},
+		{
+			name:     "sevenMinutesOnBoundary",
+			interval: 7 * time.Minute,
+			now:      time.Date(2023, 6, 1, 12, 1, 0, 0, time.UTC),
+			expected: time.Date(2023, 6, 1, 12, 8, 0, 0, time.UTC),
+		},
+		{
+			name:     "sevenMinutesJustBeforeBoundary",
+			interval: 7 * time.Minute,
+			now:      time.Date(2023, 6, 1, 12, 0, 59, 999999999, time.UTC),
+			expected: time.Date(2023, 6, 1, 12, 1, 0, 0, time.UTC),
+		},
+		{
+			// 3 full intervals of 17 hours end at 2023-06-03 12:13.
+			name:     "seventeenHours",
+			interval: 17 * time.Hour,
+			now:      time.Date(2023, 6, 3, 12, 14, 0, 0, time.UTC),
+			expected: time.Date(2023, 6, 4, 5, 13, 0, 0, time.UTC),
+		},
+		{
+			name:     "oddSeconds",
+			interval: 13*time.Second + 7*time.Millisecond,
+			now:      firstStart.Add(100 * time.Second),
+			expected: firstStart.Add(8 * (13*time.Second + 7*time.Millisecond)),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			next := NextRun(firstStart, tc.interval, tc.now)
+			if !next.Equal(tc.expected) {
+				t.Errorf("expected %v, but got %v", tc.expected, next)
+			}
+			if elapsed := next.Sub(firstStart); elapsed%tc.interval != 0 {
+				t.Errorf("expected a multiple of %v after first start, but got %v", tc.interval, elapsed)
+			}
+		})
+	}
+}
+
+func TestClockMovingBackward(t *testing.T) {
+	first := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	st := New(first, interval, WithClock(fc))
+	defer st.Stop()
+
+	fc.expectTimer(t, first)
+	fc.Set(first)
+	receive(t, st.C)
+
+	// Setting the clock back before the first start neither fires nor moves the next tick.
+	fc.expectTimer(t, first.Add(interval))
+	fc.Set(first.Add(-time.Hour))
+	expectNothing(t, st.C)
+	if next := st.NextTick(); !next.Equal(first.Add(interval)) {
+		t.Errorf("expected next tick at %v, but got %v", first.Add(interval), next)
+	}
+	if until := st.Until(); until != time.Hour+interval {
+		t.Errorf("expected next tick in %v, but got %v", time.Hour+interval, until)
+	}
+
+	// Once the clock catches up the schedule continues with a single tick.
+	fc.Set(first.Add(interval))
+	if tick := receive(t, st.C); !tick.Equal(first.Add(interval)) {
+		t.Errorf("expected tick at %v, but got %v", first.Add(interval), tick)
+	}
+	fc.expectTimer(t, first.Add(2*interval))
+	expectNothing(t, st.C)
+
+	// A Reset while the clock is behind the first start waits for it.
+	fc.Set(first.Add(-time.Hour))
+	st.Reset(first, interval)
+	fc.expectTimer(t, first)
+	expectNothing(t, st.C)
+}
+
+func TestZeroFirstStart(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(now)
+	st := New(time.Time{}, interval, WithClock(fc))
+	defer st.Stop()
+
+	fc.expectTimer(t, now.Add(interval))
+
+	fc.Advance(30 * time.Second)
+	st.Reset(time.Time{}, interval)
+	fc.expectTimer(t, fc.Now().Add(interval))
+}
+
+func TestPreviousRun(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 7, 0, 0, time.UTC)
+	cases := []struct {
+		name       string
+		firstStart time.Time
+		interval   time.Duration
+		expected   time.Time
+	}{
+		{
+			name:       "pastAnchor",
+			firstStart: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC),
+			interval:   15 * time.Minute,
+			expected:   time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "futureAnchor",
+			firstStart: time.Date(2023, 6, 2, 0, 0, 0, 0, time.UTC),
+			interval:   15 * time.Minute,
+			expected:   time.Time{},
+		},
+		{
+			name:       "exactBoundary",
+			firstStart: time.Date(2023, 6, 1, 12, 2, 0, 0, time.UTC),
+			interval:   5 * time.Minute,
+			expected:   now,
+		},
+		{
+			name:       "anchorIsNow",
+			firstStart: now,
+			interval:   time.Hour,
+			expected:   now,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if prev := PreviousRun(tc.firstStart, tc.interval, now); !prev.Equal(tc.expected) {
+				t.Errorf("expected %v, but got %v", tc.expected, prev)
+			}
+		})
+	}
+}
+
+func TestLastBoundary(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 7, 0, 0, time.UTC)
+	fc := newFakeClock(now)
+	st := New(time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC), 15*time.Minute, WithClock(fc))
+	defer st.Stop()
+
+	if last, want := st.LastBoundary(), time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC); !last.Equal(want) {
+		t.Errorf("expected %v, but got %v", want, last)
+	}
+	st.Reset(now.Add(time.Hour), time.Minute)
+	if last := st.LastBoundary(); !last.IsZero() {
+		t.Errorf("expected no boundary before a future start, but got %v", last)
+	}
+}
+
+func TestStopWithContext(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+
+	for _, tc := range []struct {
+		name       string
+		cancelCtx  bool
+		stopTicker bool
+	}{
+		{name: "context", cancelCtx: true},
+		{name: "stop", stopTicker: true},
+		{name: "both", cancelCtx: true, stopTicker: true},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			fc := newFakeClock(first)
+			st := NewWithContext(ctx, first, interval, WithClock(fc))
+			fc.expectTimer(t, first.Add(interval))
+
+			if tc.cancelCtx {
+				cancel()
+			}
+			if tc.stopTicker {
+				st.Stop()
+				st.Stop()
+			}
+			fc.expectNoTimer(t)
+			fc.Advance(interval)
+			expectNothing(t, st.C)
+
+			// Neither of these must panic after the ticker stopped.
+			st.Reset(first, interval)
+			st.Stop()
+		})
+	}
+}
+
+func TestStopped(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	st := NewWithContext(ctx, time.Now().Add(time.Hour), time.Minute)
+	dt := NewDetailed(time.Now().Add(time.Hour), time.Minute)
+	defer dt.Stop()
+
+	if st.Stopped() || dt.Stopped() {
+		t.Fatal("expected running tickers")
+	}
+	cancel()
+	dt.Stop()
+	for i := 0; i < 3; i++ {
+		if !st.Stopped() || !dt.Stopped() {
+			t.Fatal("expected stopped tickers")
+		}
+		st.Stop()
+		st.Reset(time.Now(), time.Second)
+	}
+	if !(*ScheduledTicker)(nil).Stopped() {
+		t.Error("expected nil ticker to be stopped")
+	}
+}
+
+func TestSwap(t *testing.T) {
+	t0 := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		first    time.Time
+		interval time.Duration
+		expected []time.Time
+	}{
+		{
+			name:     "between",
+			first:    t0.Add(150 * time.Second),
+			interval: time.Minute,
+			expected: []time.Time{
+				t0.Add(1 * time.Minute),
+				t0.Add(2 * time.Minute),
+				t0.Add(150 * time.Second),
+				t0.Add(210 * time.Second),
+			},
+		},
+		{
+			name:     "coinciding",
+			first:    t0.Add(2 * time.Minute),
+			interval: 2 * time.Minute,
+			expected: []time.Time{
+				t0.Add(1 * time.Minute),
+				t0.Add(2 * time.Minute),
+				t0.Add(4 * time.Minute),
+			},
+		},
+		{
+			name:     "pastAnchor",
+			first:    t0.Add(-24 * time.Hour).Add(90 * time.Second),
+			interval: 3 * time.Minute,
+			expected: []time.Time{
+				t0.Add(1 * time.Minute),
+				t0.Add(90 * time.Second),
+				t0.Add(270 * time.Second),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			fc := newFakeClock(t0.Add(10 * time.Second))
+			dt := NewDetailed(t0, time.Minute, WithClock(fc))
+			defer dt.Stop()
+
+			dt.Swap(tc.first, tc.interval)
+			for _, want := range tc.expected {
+				fc.expectTimer(t, want)
+				fc.Set(want)
+				if tick := receive(t, dt.C); !tick.Scheduled.Equal(want) {
+					t.Fatalf("expected tick at %v, but got %v", want, tick.Scheduled)
+				}
+			}
+		})
+	}
+}
+
+func TestResetAt(t *testing.T) {
+	next := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	interval := 15 * time.Minute
+	// The actual time lies before all resets so that none of them fires.
+	fc := newFakeClock(next.Add(-24 * time.Hour))
+	st := New(next, interval, WithClock(fc))
+	defer st.Stop()
+
+	cases := []struct {
+		name     string
+		now      time.Time
+		next     time.Time
+		expected time.Time
+	}{
+		{
+			name:     "beforeNext",
+			now:      next.Add(-time.Hour),
+			next:     next,
+			expected: next,
+		},
+		{
+			name:     "atNext",
+			now:      next,
+			next:     next,
+			expected: next.Add(interval),
+		},
+		{
+			name:     "afterNext",
+			now:      next.Add(40 * time.Minute),
+			next:     next,
+			expected: next.Add(45 * time.Minute),
+		},
+		{
+			name:     "zeroNext",
+			now:      next.Add(time.Minute),
+			expected: next.Add(time.Minute + interval),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			st.ResetAt(tc.now, tc.next, interval)
+			if got := st.NextTick(); !got.Equal(tc.expected) {
+				t.Errorf("expected next tick at %v, but got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestResetProportional(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(start)
+	st := New(start.Add(10*time.Second), 10*time.Second, WithClock(fc))
+	defer st.Stop()
+
+	fc.expectTimer(t, start.Add(10*time.Second))
+	// Halfway through the period the interval is doubled.
+	fc.Set(start.Add(5 * time.Second))
+	st.ResetProportional(20 * time.Second)
+	for _, want := range []time.Time{start.Add(15 * time.Second), start.Add(35 * time.Second)} {
+		fc.expectTimer(t, want)
+		fc.Set(want)
+		if tick := receive(t, st.C); !tick.Equal(want) {
+			t.Errorf("expected tick at %v, but got %v", want, tick)
+		}
+	}
+
+	// A quarter into the period the interval is shortened.
+	fc.Set(start.Add(40 * time.Second))
+	st.ResetProportional(4 * time.Second)
+	fc.expectTimer(t, start.Add(43*time.Second))
+}
+
+func TestResetPriority(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(now)
+	st := New(now, time.Minute, WithClock(fc))
+	defer st.Stop()
+
+	started := make(chan struct{})
+	proceed := make(chan struct{})
+	testHookReset = func(priority bool) {
+		if !priority {
+			close(started)
+			<-proceed
+		}
+	}
+	defer func() {
+		testHookReset = nil
+	}()
+
+	normal := Config{FirstStart: now, Interval: time.Hour}
+	override := Config{FirstStart: now, Interval: time.Second}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		st.Reset(normal.FirstStart, normal.Interval)
+	}()
+	<-started
+	st.ResetPriority(override.FirstStart, override.Interval)
+	close(proceed)
+	<-done
+
+	if st.ConfigChanged(override) {
+		t.Error("expected priority reset to win over the earlier started reset")
+	}
+
+	testHookReset = nil
+	st.Reset(normal.FirstStart, normal.Interval)
+	if st.ConfigChanged(normal) {
+		t.Error("expected reset started after the priority reset to apply")
+	}
+}
+
+func TestNilTicker(t *testing.T) {
+	var st *ScheduledTicker
+	now := time.Now()
+
+	st.Reset(now, time.Second)
+	st.ResetPriority(now, time.Second)
+	st.ResetWithPolicy(now, time.Second, FireMissed)
+	st.Swap(now, time.Second)
+	st.Fire()
+	st.ResetStats()
+	st.Unsubscribe(st.Subscribe())
+	st.Stop()
+
+	if st.ConfigChanged(Config{FirstStart: now, Interval: time.Second}) {
+		t.Error("expected no change for nil ticker")
+	}
+	if b := st.LastBoundary(); !b.IsZero() {
+		t.Errorf("expected zero last boundary, but got %v", b)
+	}
+	if next := st.NextTick(); !next.IsZero() {
+		t.Errorf("expected zero next tick, but got %v", next)
+	}
+	if until := st.Until(); until != 0 {
+		t.Errorf("expected zero until, but got %v", until)
+	}
+	if s := st.FormatCountdown(); s != "" {
+		t.Errorf("expected empty countdown, but got %q", s)
+	}
+	if s := st.Stats(); s != (Stats{}) {
+		t.Errorf("expected zero stats, but got %+v", s)
+	}
+	if h := st.History(); h != nil {
+		t.Errorf("expected no history, but got %v", h)
+	}
+	if c := st.EveryNth(2); c != nil {
+		t.Error("expected nil channel")
+	}
+	if fired, _ := st.Tick(now); fired {
+		t.Error("expected no tick")
+	}
+	if _, err := st.WaitForTick(context.Background(), 1); !errors.Is(err, ErrStopped) {
+		t.Errorf("expected %v, but got %v", ErrStopped, err)
+	}
+	if err := st.Run(context.Background(), func(context.Context, time.Time) error {
+		return errors.New("unexpected tick")
+	}); err != nil {
+		t.Errorf("expected nil error, but got %v", err)
+	}
+}
+
+func TestMaxFutureStart(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(now)
+	far := time.Date(2345, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if _, err := TryNew(far, time.Minute, WithMaxFutureStart(24*time.Hour), WithClock(fc)); !errors.Is(err, ErrFarFuture) {
+		t.Errorf("expected %v, but got %v", ErrFarFuture, err)
+	}
+	st, err := TryNew(now.Add(time.Hour), time.Minute, WithMaxFutureStart(24*time.Hour), WithClock(fc))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer st.Stop()
+	fc.expectTimer(t, now.Add(time.Hour))
+
+	if err := st.TryReset(far, time.Minute); !errors.Is(err, ErrFarFuture) {
+		t.Errorf("expected %v, but got %v", ErrFarFuture, err)
+	}
+	if next := st.NextTick(); !next.Equal(now.Add(time.Hour)) {
+		t.Errorf("expected schedule to be unchanged, but next tick is at %v", next)
+	}
+	if err := st.TryReset(now.Add(2*time.Hour), time.Minute); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	fc.expectTimer(t, now.Add(2*time.Hour))
+
+	// Without the option there is no limit.
+	st2, err := TryNew(far, time.Minute, WithClock(fc))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	st2.Stop()
+}
+
+func BenchmarkResetContention(b *testing.B) {
+	const n = 64
+	tickers := make([]*ScheduledTicker, n)
+	for i := range tickers {
+		tickers[i] = New(time.Now().Add(time.Hour), time.Hour)
+		defer tickers[i].Stop()
+	}
+	var next atomic.Uint64
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			st := tickers[next.Add(1)%n]
+			st.Reset(time.Now().Add(time.Hour), time.Hour)
+		}
+	})
+}
+
+func TestNewReady(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(first.Add(-time.Second))
+	st := NewReady(first, time.Minute, WithClock(fc))
+	defer st.Stop()
+
+	if next := st.NextTick(); !next.Equal(first) {
+		t.Errorf("expected next tick at %v, but got %v", first, next)
+	}
+	// The timer is armed without waiting for the loop.
+	fc.mu.Lock()
+	var armed []time.Time
+	for _, ft := range fc.timers {
+		if ft.active {
+			armed = append(armed, ft.when)
+		}
+	}
+	fc.mu.Unlock()
+	if len(armed) != 1 || !armed[0].Equal(first) {
+		t.Errorf("expected timer armed until %v, but got %v", first, armed)
+	}
+}
+
+func TestResetKeepsSingleTimer(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(first.Add(-time.Second))
+	st := New(first, time.Minute, WithClock(fc))
+
+	fc.expectTimer(t, first)
+	for i := 1; i <= 100; i++ {
+		st.Reset(first.Add(time.Duration(i)*time.Second), time.Minute)
+	}
+	fc.expectTimer(t, first.Add(100*time.Second))
+	if n := st.LiveTimers(); n != 1 {
+		t.Errorf("expected a single timer after resets, but got %d", n)
+	}
+	fc.mu.Lock()
+	created := len(fc.timers)
+	fc.mu.Unlock()
+	if created != 1 {
+		t.Errorf("expected the timer to be reused, but %d were created", created)
+	}
+
+	st.Stop()
+	deadline := time.Now().Add(time.Second)
+	for st.LiveTimers() != 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected timer to be stopped, but %d are live", st.LiveTimers())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestStopConcurrent(t *testing.T) {
+	st := New(time.Now(), time.Millisecond)
+	defer st.Stop()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			st.Stop()
+		}()
+	}
+	wg.Wait()
+	select {
+	case <-st.Done():
+	case <-time.After(time.Second):
+		t.Fatal("expected Done to be closed once the goroutine exited")
+	}
+	if n := st.LiveTimers(); n != 0 {
+		t.Errorf("expected no live timers after Done, but got %d", n)
+	}
+}
+
+func TestResetAfterStop(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	st := New(first, interval, WithClock(fc))
+	defer st.Stop()
+	c := st.C
+
+	st.Stop()
+	<-st.Done()
+	fc.expectNoTimer(t)
+
+	// Like a time.Timer the stopped ticker is re-armed by Reset and keeps its channel.
+	next := first.Add(interval)
+	st.Reset(next, interval)
+	if st.Stopped() {
+		t.Fatal("expected ticker to run again after Reset")
+	}
+	fc.expectTimer(t, next)
+	fc.Set(next)
+	if tick := receive(t, c); !tick.Equal(next) {
+		t.Errorf("expected tick at %v, but got %v", next, tick)
+	}
+
+	st.Stop()
+	select {
+	case <-st.Done():
+	case <-time.After(time.Second):
+		t.Fatal("expected Done of the restarted ticker to be closed after Stop")
+	}
+
+	// Tickers with a goroutine besides the loop stay stopped.
+	fn := NewFunc(first, interval, func(time.Time) {}, WithClock(fc))
+	fn.Stop()
+	fn.Reset(next, interval)
+	if !fn.Stopped() {
+		t.Error("expected ticker of NewFunc to stay stopped")
+	}
+}
+
+// TestConcurrentUse is meant to be run with the race detector.
+func TestConcurrentUse(t *testing.T) {
+	st := New(time.Now(), time.Millisecond)
+	defer st.Stop()
+	sub := st.Subscribe()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				interval := time.Duration(i+1) * time.Millisecond
+				switch j % 5 {
+				case 0:
+					st.Reset(time.Now(), interval)
+				case 1:
+					st.Swap(time.Now(), interval)
+				case 2:
+					st.Pause()
+					st.Resume()
+				case 3:
+					st.NextTick()
+					st.Stats()
+					st.LastBoundary()
+				case 4:
+					st.Fire()
+				}
+			}
+		}(i)
+	}
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-st.C:
+			case <-sub:
+			case <-done:
+				return
+			}
+		}
+	}()
+	wg.Wait()
+	close(done)
+
+	// The last Reset determines the schedule as a whole.
+	c := Config{FirstStart: time.Now().Add(time.Hour), Interval: time.Minute}
+	st.Reset(c.FirstStart, c.Interval)
+	if st.ConfigChanged(c) {
+		t.Errorf("expected schedule %+v of the last Reset", c)
+	}
+}
@@ -0,0 +1,191 @@
+package sticker
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// subscriber is a channel attached to a ScheduledTicker in addition to C.
+type subscriber struct {
+	c      chan time.Time
+	nth    uint64 // Only every nth tick is sent on c.
+	newest bool   // Replace a pending tick instead of dropping the new one.
+
+	delivered atomic.Uint64
+	dropped   atomic.Uint64
+}
+
+// send delivers t to the subscriber if it is due for it.
+// Manual ticks are only due for subscribers of every tick.
+func (s *subscriber) send(t Tick) {
+	if s.nth == 1 || !t.Manual && t.Seq%s.nth == 0 {
+		if send(s.c, t.stamp(), s.newest) {
+			s.delivered.Add(1)
+		} else {
+			s.dropped.Add(1)
+		}
+	}
+}
+
+// Subscribe returns a new channel on which the ticks are delivered in addition to C.
+// Every subscriber has its own buffer of the size set by WithBufferSize, 1 by default, so that
+// a slow subscriber only misses ticks itself but does not hold back other subscribers.
+// Ticks are dropped from a full buffer according to the DropPolicy of the ticker.
+func (st *ScheduledTicker) Subscribe() <-chan time.Time {
+	if st == nil {
+		return nil
+	}
+	return st.subscribe(1, st.coalesce, st.bufferSize)
+}
+
+// SubscribeBuffered is like Subscribe but gives the subscriber a buffer of n ticks regardless of
+// WithBufferSize, e.g. for a consumer that is slower than the others but must not miss ticks.
+// n must be greater than zero; if not, SubscribeBuffered will panic.
+func (st *ScheduledTicker) SubscribeBuffered(n int) <-chan time.Time {
+	if st == nil {
+		return nil
+	}
+	if n <= 0 {
+		panic(errors.New("non-positive n for ScheduledTicker.SubscribeBuffered"))
+	}
+	return st.subscribe(1, st.coalesce, n)
+}
+
+// CoalescedC returns a new channel on which a tick is pending whenever at least one tick happened
+// since the channel was last read. It always holds the latest tick: a tick that is not yet received
+// is replaced by the next one instead of the new tick being dropped, so a read never yields a stale tick,
+// however many ticks elapsed in between. This is the behavior of WithCoalesceNewest for a single channel.
+// The channel is a subscriber like those returned by Subscribe and is detached by Unsubscribe.
+func (st *ScheduledTicker) CoalescedC() <-chan time.Time {
+	if st == nil {
+		return nil
+	}
+	return st.subscribe(1, true, 1)
+}
+
+// EveryNth returns a new channel on which only every nth tick of the ticker is delivered, e.g.
+// to trigger a heavier job on every fifth tick without a second ticker. Since it is derived from
+// the ticks of st it stays aligned to its schedule. The channel is a subscriber like those returned
+// by Subscribe and is detached by Unsubscribe.
+// n must be greater than zero; if not, EveryNth will panic.
+func (st *ScheduledTicker) EveryNth(n int) <-chan time.Time {
+	if st == nil {
+		return nil
+	}
+	if n <= 0 {
+		panic(errors.New("non-positive n for ScheduledTicker.EveryNth"))
+	}
+	return st.subscribe(uint64(n), st.coalesce, st.bufferSize)
+}
+
+// subscribe attaches a new subscriber of every nth tick with a buffer of size ticks.
+func (st *ScheduledTicker) subscribe(nth uint64, newest bool, size int) <-chan time.Time {
+	c := make(chan time.Time, size)
+	if st.setSubs(func(subs []*subscriber) []*subscriber {
+		return append(subs, &subscriber{c: c, nth: nth, newest: newest})
+	}) {
+		st.notify()
+	}
+	return c
+}
+
+// setSubs replaces the subscribers of st by the result of update and reports whether st resumed or paused
+// for it because of WithAutoPause. It releases st.mu even if the schedule panics.
+func (st *ScheduledTicker) setSubs(update func([]*subscriber) []*subscriber) bool {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	paused := st.isPaused()
+	st.subs = update(st.subs)
+	if st.isPaused() == paused {
+		return false
+	}
+	st.reschedule(st.clock.Now())
+	return true
+}
+
+// Unsubscribe detaches a channel returned by Subscribe or SubscribeBuffered. No more ticks will be sent on it.
+// Unsubscribe does not close the channel, to prevent a concurrent goroutine
+// reading from the channel from seeing an erroneous "tick".
+func (st *ScheduledTicker) Unsubscribe(c <-chan time.Time) {
+	if st == nil {
+		return
+	}
+	if st.setSubs(func(old []*subscriber) []*subscriber {
+		subs := make([]*subscriber, 0, len(old))
+		for _, sub := range old {
+			if sub.c != c {
+				subs = append(subs, sub)
+			}
+		}
+		return subs
+	}) {
+		st.notify()
+	}
+}
+
+// SubscriberStat describes a subscriber of a ScheduledTicker.
+type SubscriberStat struct {
+	C         <-chan time.Time // The channel of the subscriber.
+	Nth       int              // The subscriber receives every Nth tick, see EveryNth.
+	Delivered uint64           // Number of ticks delivered to the subscriber.
+	Dropped   uint64           // Number of ticks dropped because the subscriber was behind.
+	Pending   int              // Number of ticks not yet received by the subscriber.
+}
+
+// Subscribers returns the current subscribers of the ticker in the order they subscribed.
+// It helps to identify subscribers that fall behind, which shows as a growing number of dropped ticks.
+func (st *ScheduledTicker) Subscribers() []SubscriberStat {
+	if st == nil {
+		return nil
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	stats := make([]SubscriberStat, 0, len(st.subs))
+	for _, sub := range st.subs {
+		stats = append(stats, SubscriberStat{
+			C:         sub.c,
+			Nth:       int(sub.nth),
+			Delivered: sub.delivered.Load(),
+			Dropped:   sub.dropped.Load(),
+			Pending:   len(sub.c),
+		})
+	}
+	return stats
+}
+
+// AnyFired returns a channel that is signaled whenever any of tickers ticks, e.g. to refresh
+// something that depends on several schedules. Signals are coalesced: however many ticks happen
+// until the channel is read, only one signal is pending. The ticks are observed via a subscriber
+// on each ticker so C is not affected. A ticker that is stopped is detached on its own and stays detached
+// even if a Reset re-arms it later; once all of them are stopped the returned channel is closed.
+// Like everywhere else a nil ticker counts as stopped.
+func AnyFired(tickers ...*ScheduledTicker) <-chan struct{} {
+	c := make(chan struct{}, 1)
+	var wg sync.WaitGroup
+	for _, st := range tickers {
+		if st == nil {
+			continue
+		}
+		wg.Add(1)
+		// NOTE: watch the current run only so that a Reset re-arming the ticker does not keep it attached.
+		go func(st *ScheduledTicker, sub <-chan time.Time, stopped <-chan struct{}) {
+			defer wg.Done()
+			defer st.Unsubscribe(sub)
+			for {
+				select {
+				case <-sub:
+					send(c, struct{}{}, false)
+				case <-stopped:
+					return
+				}
+			}
+		}(st, st.Subscribe(), st.ctx().Done())
+	}
+	go func() {
+		wg.Wait()
+		close(c)
+	}()
+	return c
+}
@@ -0,0 +1,263 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSubscribe(t *testing.T) {
+	st := New(time.Now(), 10*time.Millisecond)
+	defer st.Stop()
+
+	a := st.Subscribe()
+	b := st.Subscribe()
+	for _, c := range []<-chan time.Time{a, b} {
+		select {
+		case <-c:
+		case <-time.After(time.Second):
+			t.Fatal("subscriber did not receive a tick")
+		}
+	}
+
+	st.Unsubscribe(a)
+	drain(a) // a tick might have been sent before unsubscribing
+	<-b
+	select {
+	case <-a:
+		t.Error("unsubscribed channel received a tick")
+	case <-time.After(30 * time.Millisecond):
+	}
+}
+
+func TestAutoPause(t *testing.T) {
+	first := time.Now()
+	interval := 20 * time.Millisecond
+	st := New(first, interval, WithAutoPause())
+	defer st.Stop()
+
+	if next := st.NextTick(); !next.IsZero() {
+		t.Fatalf("expected no timer without subscribers, but next tick is at %v", next)
+	}
+
+	c := st.Subscribe()
+	<-c
+	<-st.C
+
+	st.Unsubscribe(c)
+	drain(st.C)
+	if next := st.NextTick(); !next.IsZero() {
+		t.Errorf("expected timer to be released when idle, but next tick is at %v", next)
+	}
+	select {
+	case <-st.C:
+		t.Error("ticked while idle")
+	case <-time.After(3 * interval):
+	}
+
+	c = st.Subscribe()
+	next := st.NextTick()
+	if next.IsZero() {
+		t.Fatal("expected timer to be armed after re-attaching")
+	}
+	if offset := next.Sub(first) % interval; offset != 0 {
+		t.Errorf("expected next tick to be aligned to schedule, but it is off by %v", offset)
+	}
+	select {
+	case <-c:
+	case <-time.After(time.Second):
+		t.Error("did not tick after re-attaching")
+	}
+}
+
+// drain removes a pending tick from c, if any.
+func drain[T any](c <-chan T) {
+	select {
+	case <-c:
+	default:
+	}
+}
+
+func TestEveryNth(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	st := New(first, interval, WithClock(fc))
+	defer st.Stop()
+
+	fifth := st.EveryNth(5)
+	for i := 1; i <= 10; i++ {
+		fc.Set(fc.awaitTimer(t))
+		tick := receive(t, st.C)
+		if i%5 != 0 {
+			expectNothing(t, fifth)
+			continue
+		}
+		if derived := receive(t, fifth); !derived.Equal(tick) {
+			t.Errorf("expected derived tick at %v, but got %v", tick, derived)
+		}
+	}
+}
+
+func TestEveryNthNonPositive(t *testing.T) {
+	defer func() {
+		if err := recover(); err == nil {
+			t.Errorf("EveryNth(0) should have panicked")
+		}
+	}()
+	st := New(time.Now(), time.Second)
+	defer st.Stop()
+	st.EveryNth(0)
+}
+
+func TestCoalescedC(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Millisecond
+	fc := newFakeClock(first.Add(-time.Second))
+	st := New(first, interval, WithClock(fc))
+	defer st.Stop()
+	c := st.CoalescedC()
+
+	// The reader only reads after several ticks happened and always gets the latest one.
+	var latest time.Time
+	for n := 0; n < 3; n++ {
+		for i := 0; i < 5; i++ {
+			latest = first.Add(time.Duration(5*n+i) * interval)
+			fc.expectTimer(t, latest)
+			fc.Set(latest)
+		}
+		fc.expectTimer(t, latest.Add(interval))
+		if tick := receive(t, c); !tick.Equal(latest) {
+			t.Errorf("expected latest tick at %v, but got %v", latest, tick)
+		}
+		expectNothing(t, c)
+	}
+}
+
+func TestAnyFired(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(first.Add(-time.Second))
+	st1 := New(first, time.Minute, WithClock(fc))
+	defer st1.Stop()
+	st2 := New(first.Add(30*time.Second), time.Minute, WithClock(fc))
+	defer st2.Stop()
+	sub1, sub2 := st1.Subscribe(), st2.Subscribe()
+
+	fired := AnyFired(st1, st2)
+	expectNothing(t, fired)
+
+	// Both tickers fire before the signal is read.
+	fc.expectTimer(t, first)
+	fc.Set(first)
+	receive(t, sub1)
+	fc.expectTimer(t, first.Add(30*time.Second))
+	fc.Set(first.Add(30 * time.Second))
+	receive(t, sub2)
+	// Give the signal of the second ticker time to arrive.
+	time.Sleep(10 * time.Millisecond)
+	receive(t, fired)
+	expectNothing(t, fired)
+
+	fc.expectTimer(t, first.Add(time.Minute))
+	fc.Set(first.Add(time.Minute))
+	receive(t, sub1)
+	receive(t, fired)
+	expectNothing(t, fired)
+
+	// Stopping one ticker keeps the signal of the other one.
+	st1.Stop()
+	fc.expectTimer(t, first.Add(90*time.Second))
+	fc.Set(first.Add(90 * time.Second))
+	receive(t, sub2)
+	receive(t, fired)
+
+	st2.Stop()
+	if _, ok := <-fired; ok {
+		t.Error("expected channel to be closed after all tickers stopped")
+	}
+}
+
+func TestSubscribers(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	st := New(first, interval, WithClock(fc))
+	defer st.Stop()
+	fast, slow := st.Subscribe(), st.Subscribe()
+
+	for i := 0; i < 3; i++ {
+		next := first.Add(time.Duration(i) * interval)
+		fc.expectTimer(t, next)
+		fc.Set(next)
+		receive(t, fast)
+	}
+	fc.expectTimer(t, first.Add(3*interval))
+
+	stats := st.Subscribers()
+	if len(stats) != 2 {
+		t.Fatalf("expected 2 subscribers, but got %d", len(stats))
+	}
+	if s, want := stats[0], (SubscriberStat{C: fast, Nth: 1, Delivered: 3}); s != want {
+		t.Errorf("expected fast subscriber %+v, but got %+v", want, s)
+	}
+	if s, want := stats[1], (SubscriberStat{C: slow, Nth: 1, Delivered: 1, Dropped: 2, Pending: 1}); s != want {
+		t.Errorf("expected slow subscriber %+v, but got %+v", want, s)
+	}
+
+	st.Unsubscribe(slow)
+	if stats := st.Subscribers(); len(stats) != 1 || stats[0].C != fast {
+		t.Errorf("expected only the fast subscriber, but got %+v", stats)
+	}
+}
+
+func TestSubscribeBuffered(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	mt := NewManualTicker(WithBufferSize(2))
+	defer mt.Stop()
+	buffered := mt.SubscribeBuffered(3)
+	sub := mt.Subscribe()
+	if n := cap(sub); n != 2 {
+		t.Errorf("expected subscriber to get the buffer size of the ticker 2, but got %d", n)
+	}
+
+	for i := 0; i < 4; i++ {
+		mt.FireAt(now.Add(time.Duration(i) * time.Second))
+	}
+	// Each subscriber keeps as many ticks as its own buffer holds.
+	for i := 0; i < 3; i++ {
+		if tick, want := receive(t, buffered), now.Add(time.Duration(i)*time.Second); !tick.Equal(want) {
+			t.Errorf("expected tick at %v, but got %v", want, tick)
+		}
+	}
+	expectNothing(t, buffered)
+	for i := 0; i < 2; i++ {
+		receive(t, sub)
+	}
+	expectNothing(t, sub)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for non-positive buffer")
+		}
+	}()
+	mt.SubscribeBuffered(0)
+}
+
+func TestAnyFiredNil(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(first.Add(-time.Second))
+	st := New(first, time.Minute, WithClock(fc))
+	defer st.Stop()
+
+	fired := AnyFired(nil, st)
+	fc.expectTimer(t, first)
+	fc.Set(first)
+	receive(t, fired)
+
+	st.Stop()
+	if _, ok := <-fired; ok {
+		t.Error("expected channel to be closed after the only ticker stopped")
+	}
+	if _, ok := <-AnyFired(nil); ok {
+		t.Error("expected channel of only nil tickers to be closed")
+	}
+}
@@ -0,0 +1,54 @@
+package sticker
+
+import "time"
+
+// supervisorDelay is how long a supervised ticker waits before restarting its loop after a panic.
+const supervisorDelay = 100 * time.Millisecond
+
+// WithSupervisor makes the ticker recover from a panic of its goroutine, e.g. caused by a predicate
+// of WithPredicate or a Schedule of NewFromSchedule, and restart it after a short delay. The schedule
+// is preserved, so a tick that is due by then fires right away. Every restart is counted by
+// Stats.Restarts, and the recovered value is passed to the handler of WithPanicHandler if there is one.
+// Without it such a panic crashes the program.
+func WithSupervisor() Option {
+	return func(st *ScheduledTicker) {
+		st.supervise = true
+	}
+}
+
+// run runs the loop of st until the session s is stopped.
+func (st *ScheduledTicker) run(s *session) {
+	defer close(s.exited)
+	defer active.Add(-1)
+	if !st.supervise {
+		st.loop(s)
+		return
+	}
+	for !st.recoverLoop(s) {
+		timer := st.clock.NewTimer(supervisorDelay)
+		select {
+		case <-timer.C():
+		case <-s.ctx.Done():
+			timer.Stop()
+			return
+		}
+	}
+}
+
+// recoverLoop runs the loop of st and reports whether it returned without a panic.
+func (st *ScheduledTicker) recoverLoop(s *session) (ok bool) {
+	defer func() {
+		if ok {
+			return
+		}
+		r := recover()
+		st.mu.Lock()
+		st.stats.Restarts++
+		st.mu.Unlock()
+		if st.onPanic != nil {
+			st.onPanic(r)
+		}
+	}()
+	st.loop(s)
+	return true
+}
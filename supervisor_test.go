@@ -0,0 +1,100 @@
+package sticker
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSupervisor(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	var calls atomic.Int32
+	fc := newFakeClock(first.Add(-time.Second))
+	st := New(first, interval, WithSupervisor(), WithPredicate(func(time.Time) bool {
+		if calls.Add(1) == 2 {
+			panic("predicate failed")
+		}
+		return true
+	}), WithClock(fc))
+	defer st.Stop()
+
+	fc.expectTimer(t, first)
+	fc.Set(first)
+	receive(t, st.C)
+
+	// The second tick crashes the loop which is restarted and fires the tick.
+	next := first.Add(interval)
+	fc.expectTimer(t, next)
+	fc.Set(next)
+	restart := next.Add(supervisorDelay)
+	fc.expectTimer(t, restart)
+	expectNothing(t, st.C)
+	fc.Set(restart)
+	if tick := receive(t, st.C); !tick.Equal(restart) {
+		t.Errorf("expected tick at %v, but got %v", restart, tick)
+	}
+
+	// The schedule continues.
+	fc.expectTimer(t, first.Add(2*interval))
+	fc.Set(first.Add(2 * interval))
+	if tick := receive(t, st.C); !tick.Equal(first.Add(2 * interval)) {
+		t.Errorf("expected tick at %v, but got %v", first.Add(2*interval), tick)
+	}
+}
+
+// panicking is a Schedule ticking every minute from start that panics once when asked for the tick after at.
+type panicking struct {
+	start, at time.Time
+	panicked  *atomic.Bool
+}
+
+func (p panicking) Next(after time.Time) time.Time {
+	if after.Equal(p.at) && p.panicked.CompareAndSwap(false, true) {
+		panic("schedule failed")
+	}
+	return NextRun(p.start, time.Minute, after)
+}
+
+func TestSupervisorSchedulePanic(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	second := first.Add(time.Minute)
+	fc := newFakeClock(first.Add(-time.Second))
+	recovered := make(chan any, 1)
+	s := panicking{start: first, at: second, panicked: new(atomic.Bool)}
+	st := NewFromSchedule(s, WithSupervisor(), WithPanicHandler(func(r any) { recovered <- r }), WithClock(fc))
+	defer st.Stop()
+
+	fc.expectTimer(t, first)
+	fc.Set(first)
+	receive(t, st.C)
+
+	// Scheduling the tick after the second one panics while the ticker is locked.
+	fc.expectTimer(t, second)
+	fc.Set(second)
+	if r := receive(t, recovered); r != "schedule failed" {
+		t.Errorf("expected the panic of the schedule to be handled, but got %v", r)
+	}
+	next := make(chan time.Time)
+	go func() { next <- st.NextTick() }()
+	if tick := receive(t, next); !tick.Equal(second) {
+		t.Errorf("expected the second tick to be pending, but got %v", tick)
+	}
+	if restarts := st.Stats().Restarts; restarts != 1 {
+		t.Errorf("expected 1 restart, but got %d", restarts)
+	}
+
+	// The restarted loop fires the pending tick and the schedule continues.
+	restart := second.Add(supervisorDelay)
+	fc.expectTimer(t, restart)
+	fc.Set(restart)
+	if tick := receive(t, st.C); !tick.Equal(restart) {
+		t.Errorf("expected tick at %v, but got %v", restart, tick)
+	}
+	if next := st.NextTick(); !next.Equal(first.Add(2 * time.Minute)) {
+		t.Errorf("expected next tick at %v, but got %v", first.Add(2*time.Minute), next)
+	}
+
+	st.Stop()
+	receive(t, st.Done())
+}
@@ -0,0 +1,77 @@
+package sticker
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Tick describes a single tick of a DetailedTicker.
+type Tick struct {
+	Seq       uint64    // Sequence number of the tick starting at 1. Gaps indicate dropped ticks.
+	Scheduled time.Time // The point in time the tick was scheduled for.
+	Actual    time.Time // The point in time the tick actually fired.
+	Dropped   uint64    // Number of ticks dropped since the last delivered one.
+	Missed    uint64    // Number of ticks of the schedule skipped since the last fired one because the ticker fell behind.
+	Manual    bool      // Whether the tick was fired by Fire instead of the schedule. Manual ticks have no Seq.
+	Heartbeat bool      // Whether the tick is a heartbeat of WithHeartbeat. Heartbeats have no Seq.
+	Index     uint64    // Number of intervals from the first start of the schedule to Scheduled, 0 for the first tick.
+	Phase     int       // Index modulo 2 alternating with the boundaries of the schedule, e.g. to route ticks A/B. Without Index it alternates with Seq.
+	Late      bool      // Whether the tick was due before the ticker was created, e.g. a missed deadline of NewDeadline.
+
+	window time.Time // The start of the window the tick ends if set by WithWindowStart.
+}
+
+// stamp returns the time delivered for the tick on channels of plain times.
+func (t Tick) stamp() time.Time {
+	if !t.window.IsZero() {
+		return t.window
+	}
+	return t.Actual
+}
+
+// Drift returns how late the tick fired compared to its schedule.
+func (t Tick) Drift() time.Duration {
+	return t.Actual.Sub(t.Scheduled)
+}
+
+// DetailedTicker is a ScheduledTicker that delivers a [Tick] for every tick instead of just the current time.
+type DetailedTicker struct {
+	*ScheduledTicker
+
+	C <-chan Tick // The channel on which the ticks are delivered.
+}
+
+// NewDetailed returns a new DetailedTicker that starts
+// ticking at time first in the given interval.
+// The duration interval must be greater than zero; if not, NewDetailed will
+// panic. Stop the ticker to release associated resources.
+func NewDetailed(first time.Time, interval time.Duration, opts ...Option) *DetailedTicker {
+	if interval <= 0 {
+		panic(errors.New("non-positive interval for NewDetailed DetailedTicker"))
+	}
+	ticker := newDetailed(context.Background(), opts)
+	ticker.start(first, interval)
+	return ticker
+}
+
+// newDetailed returns a DetailedTicker configured by opts that stops once ctx is done.
+// It is not running until started.
+func newDetailed(ctx context.Context, opts []Option) *DetailedTicker {
+	st := newTicker(ctx, opts)
+	c := make(chan Tick, st.bufferSize)
+	ticker := &DetailedTicker{
+		ScheduledTicker: st,
+		C:               c,
+	}
+	ticker.deliver = func(t Tick, wait bool) bool {
+		if wait {
+			return sendWait(ticker.ctx(), c, t)
+		}
+		return send(c, t, ticker.coalesce)
+	}
+	if ticker.closeOnStop {
+		ticker.closeWhenStopped(func() { close(c) })
+	}
+	return ticker
+}
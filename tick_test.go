@@ -0,0 +1,162 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDetailedSeq(t *testing.T) {
+	interval := 20 * time.Millisecond
+	dt := NewDetailed(time.Now(), interval)
+	defer dt.Stop()
+
+	for i := uint64(1); i <= 3; i++ {
+		tick := <-dt.C
+		if tick.Seq != i {
+			t.Errorf("expected seq %d, but got %d", i, tick.Seq)
+		}
+		if tick.Dropped != 0 {
+			t.Errorf("expected no dropped ticks, but got %d", tick.Dropped)
+		}
+	}
+}
+
+func TestDetailedDrift(t *testing.T) {
+	interval := 20 * time.Millisecond
+	first := time.Now().Add(interval)
+	dt := NewDetailed(first, interval)
+	defer dt.Stop()
+
+	for i := 0; i < 3; i++ {
+		tick := <-dt.C
+		if want := first.Add(time.Duration(i) * interval); !tick.Scheduled.Equal(want) {
+			t.Errorf("expected tick scheduled at %v, but got %v", want, tick.Scheduled)
+		}
+		if drift := tick.Drift(); drift < 0 || drift > 10*time.Millisecond {
+			t.Errorf("unexpected drift %v", drift)
+		}
+	}
+}
+
+func TestDetailedDropped(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode")
+	}
+	interval := 10 * time.Millisecond
+	dt := NewDetailed(time.Now(), interval)
+	defer dt.Stop()
+
+	time.Sleep(6 * interval)
+	first := <-dt.C
+	second := <-dt.C
+	if second.Dropped == 0 {
+		t.Fatal("expected dropped ticks while not reading")
+	}
+	if want := second.Seq - first.Seq - 1; second.Dropped != want {
+		t.Errorf("expected %d dropped ticks, but got %d", want, second.Dropped)
+	}
+}
+
+func TestDetailedIndex(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	// The ticker starts after several intervals of its schedule already passed.
+	fc := newFakeClock(start.Add(5*interval + time.Second))
+	dt := NewDetailed(start, interval, WithClock(fc))
+	defer dt.Stop()
+
+	for i := uint64(6); i < 9; i++ {
+		next := start.Add(time.Duration(i) * interval)
+		fc.expectTimer(t, next)
+		fc.Set(next)
+		tick := receive(t, dt.C)
+		if tick.Index != i {
+			t.Errorf("expected index %d, but got %d", i, tick.Index)
+		}
+		if elapsed := dt.IntervalsElapsed(); tick.Index != elapsed {
+			t.Errorf("expected index to match %d elapsed intervals, but got %d", elapsed, tick.Index)
+		}
+	}
+
+	// The index restarts with the new first start of a Reset.
+	next := fc.Now().Add(interval)
+	dt.Reset(next, interval)
+	fc.expectTimer(t, next)
+	fc.Set(next)
+	if tick := receive(t, dt.C); tick.Index != 0 || dt.IntervalsElapsed() != 0 {
+		t.Errorf("expected index 0 after Reset, but got %d", tick.Index)
+	}
+}
+
+func TestDetailedPhase(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	dt := NewDetailed(first, interval, WithClock(fc))
+	defer dt.Stop()
+
+	for i := 0; i < 6; i++ {
+		next := first.Add(time.Duration(i) * interval)
+		fc.expectTimer(t, next)
+		fc.Set(next)
+		if tick := receive(t, dt.C); tick.Phase != i%2 {
+			t.Errorf("expected phase %d of tick %d, but got %d", i%2, tick.Seq, tick.Phase)
+		}
+	}
+}
+
+func TestDetailedMissed(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	dt := NewDetailed(first, interval, WithClock(fc))
+	defer dt.Stop()
+
+	fc.expectTimer(t, first)
+	fc.Set(first)
+	if tick := receive(t, dt.C); tick.Missed != 0 {
+		t.Errorf("expected no missed ticks, but got %d", tick.Missed)
+	}
+
+	// The ticker falls behind so that the ticks at 2m and 3m are skipped.
+	fc.expectTimer(t, first.Add(interval))
+	fc.Set(first.Add(3*interval + 30*time.Second))
+	if tick := receive(t, dt.C); tick.Missed != 0 || !tick.Scheduled.Equal(first.Add(interval)) {
+		t.Errorf("expected late tick scheduled at %v without missed ticks, but got %+v", first.Add(interval), tick)
+	}
+	fc.expectTimer(t, first.Add(4*interval))
+	fc.Set(first.Add(4 * interval))
+	if tick := receive(t, dt.C); tick.Missed != 2 {
+		t.Errorf("expected 2 missed ticks, but got %d", tick.Missed)
+	}
+
+	fc.expectTimer(t, first.Add(5*interval))
+	fc.Set(first.Add(5 * interval))
+	if tick := receive(t, dt.C); tick.Missed != 0 {
+		t.Errorf("expected no missed ticks, but got %d", tick.Missed)
+	}
+}
+
+func TestDetailedPhaseSkipped(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	fc := newFakeClock(first.Add(-time.Second))
+	dt := NewDetailed(first, interval, WithPredicate(func(scheduled time.Time) bool {
+		return !scheduled.Equal(first.Add(2 * interval))
+	}), WithClock(fc))
+	defer dt.Stop()
+
+	// The phase follows the boundaries of the schedule although the third tick is skipped.
+	for i := 0; i < 5; i++ {
+		next := first.Add(time.Duration(i) * interval)
+		fc.expectTimer(t, next)
+		fc.Set(next)
+		if i == 2 {
+			expectNothing(t, dt.C)
+			continue
+		}
+		if tick := receive(t, dt.C); tick.Phase != i%2 {
+			t.Errorf("expected phase %d of the tick at %v, but got %d", i%2, tick.Scheduled, tick.Phase)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package sticker
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// FromTimeTicker returns a new ScheduledTicker that delivers the ticks of t, but only those at or after first,
+// e.g. to migrate code that already uses a time.Ticker step by step. Ticks of t before first are discarded.
+// From then on the ticks follow the cadence of t and carry the time they were received from t.
+// The ScheduledTicker takes ownership of t: Stop also stops t, and t must not be used otherwise
+// afterwards, in particular not be read from. Since t defines the schedule, Reset, Swap and Clone
+// have no effect on the delivered ticks and NextTick is unknown. A zero first delivers all ticks of t.
+// t must not be nil; if it is, FromTimeTicker will panic.
+func FromTimeTicker(t *time.Ticker, first time.Time, opts ...Option) *ScheduledTicker {
+	if t == nil {
+		panic(errors.New("nil time.Ticker for FromTimeTicker ScheduledTicker"))
+	}
+	ticker := newChanTicker(context.Background(), opts)
+	// NOTE: like a manual ticker it has no loop of its own that would need to be notified.
+	ticker.manual = true
+	// NOTE: t is stopped along with the ticker, so there is nothing to restart.
+	ticker.final = true
+	s := ticker.session.Load()
+	s.exited = make(chan struct{})
+	active.Add(1)
+	go ticker.relay(s, t, first)
+	return ticker
+}
+
+// relay fires the ticks of t at or after first until the session s is stopped.
+func (st *ScheduledTicker) relay(s *session, t *time.Ticker, first time.Time) {
+	defer close(s.exited)
+	defer active.Add(-1)
+	defer t.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case now := <-t.C:
+			if now.Before(first) {
+				continue
+			}
+			st.fireExternal(now)
+		}
+	}
+}
+
+// fireExternal fires a tick at now whose point in time is determined outside of the ticker,
+// e.g. by a time.Ticker, counting it like a tick of the schedule.
+func (st *ScheduledTicker) fireExternal(now time.Time) {
+	st.mu.Lock()
+	st.seq++
+	// NOTE: without a schedule there is no index, so the phase alternates from tick to tick.
+	tick := Tick{
+		Seq:       st.seq,
+		Scheduled: now,
+		Actual:    now,
+		Dropped:   st.dropped,
+		Phase:     int((st.seq - 1) % 2),
+	}
+	if st.loc != nil {
+		tick.Scheduled, tick.Actual = now.In(st.loc), now.In(st.loc)
+	}
+	subs := st.subs
+	st.mu.Unlock()
+	st.fire(tick, subs, tick.Seq <= st.guaranteed)
+}
@@ -0,0 +1,30 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFromTimeTicker(t *testing.T) {
+	interval := 10 * time.Millisecond
+	first := time.Now().Add(5 * interval)
+	st := FromTimeTicker(time.NewTicker(interval), first)
+	defer st.Stop()
+
+	var prev time.Time
+	for i := 0; i < 3; i++ {
+		tick := receive(t, st.C)
+		if tick.Before(first) {
+			t.Errorf("expected no tick before %v, but got %v", first, tick)
+		}
+		if !prev.IsZero() && tick.Sub(prev) < interval/2 {
+			t.Errorf("expected ticks about %v apart, but got %v", interval, tick.Sub(prev))
+		}
+		prev = tick
+	}
+
+	st.Stop()
+	time.Sleep(2 * interval)
+	drain(st.C)
+	expectNothing(t, st.C)
+}
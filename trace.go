@@ -0,0 +1,30 @@
+package sticker
+
+// WithLoopTrace calls trace with an event for every transition of the internal state of the ticker,
+// e.g. to diagnose timing issues between Reset and ticks. The events are:
+//
+//   - "reset-received": the loop was woken up by a change of the schedule, e.g. by Reset.
+//   - "ready": the ready signal of WithReadySignal arrived.
+//   - "gate": the gate of WithGate was enabled or disabled.
+//   - "timer-fired": the timer of the loop expired.
+//   - "tick-skipped": a tick was skipped by the predicate of WithPredicate or the gate of WithGate.
+//   - "tick-delivered": a tick was delivered on C.
+//   - "tick-dropped": a tick was dropped because C was full.
+//   - "tick-held": a tick was held back until an Ack, see WithAck.
+//   - "heartbeat": a heartbeat of WithHeartbeat fired.
+//   - "stopped": the loop exited because the ticker was stopped.
+//
+// trace is called from the goroutines that fire ticks, mostly the one of the ticker, and must return quickly.
+// Without this option tracing has no cost.
+func WithLoopTrace(trace func(event string)) Option {
+	return func(st *ScheduledTicker) {
+		st.trace = trace
+	}
+}
+
+// traceEvent reports event to the trace of WithLoopTrace if set.
+func (st *ScheduledTicker) traceEvent(event string) {
+	if st.trace != nil {
+		st.trace(event)
+	}
+}
@@ -0,0 +1,32 @@
+package sticker
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLoopTrace(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(first.Add(-time.Second))
+	events := make(chan string, 10)
+	st := New(first.Add(time.Hour), time.Hour, WithLoopTrace(func(event string) {
+		events <- event
+	}), WithClock(fc))
+
+	fc.expectTimer(t, first.Add(time.Hour))
+	st.Reset(first, time.Hour)
+	fc.expectTimer(t, first)
+	fc.Set(first)
+	receive(t, st.C)
+	st.Stop()
+
+	var got []string
+	for event := ""; event != "stopped"; {
+		event = receive(t, events)
+		got = append(got, event)
+	}
+	if want := []string{"reset-received", "timer-fired", "tick-delivered", "stopped"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected events %q, but got %q", want, got)
+	}
+}
@@ -0,0 +1,55 @@
+package sticker
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// WaitForTick blocks until the nth tick after the call has been delivered on C and returns it.
+// Ticks that are dropped because C is full are not counted. WaitForTick does not receive from C itself,
+// so the ticks are still available there. If ctx is done before, its error is returned; if the ticker
+// is stopped before, ErrStopped is returned. n must be positive; if not, WaitForTick will panic.
+func (st *ScheduledTicker) WaitForTick(ctx context.Context, n int) (time.Time, error) {
+	if st == nil {
+		return time.Time{}, ErrStopped
+	}
+	if n <= 0 {
+		panic(errors.New("non-positive n for ScheduledTicker.WaitForTick"))
+	}
+	st.mu.Lock()
+	d := st.delivery
+	st.mu.Unlock()
+	for ; ; n-- {
+		select {
+		case <-d.done:
+		case <-ctx.Done():
+			return time.Time{}, ctx.Err()
+		case <-st.ctx().Done():
+			return time.Time{}, ErrStopped
+		}
+		if n == 1 {
+			return d.tick, nil
+		}
+		d = d.next
+	}
+}
+
+// delivery is a future delivery of a tick. Once done is closed tick and next are set.
+type delivery struct {
+	done chan struct{}
+	tick time.Time
+	next *delivery
+}
+
+func newDelivery() *delivery {
+	return &delivery{done: make(chan struct{})}
+}
+
+// complete records t as delivered and returns the next delivery.
+func (d *delivery) complete(t time.Time) *delivery {
+	d.tick = t
+	d.next = newDelivery()
+	close(d.done)
+	return d.next
+}
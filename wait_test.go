@@ -0,0 +1,95 @@
+package sticker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWaitForTick(t *testing.T) {
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := time.Minute
+
+	for _, tc := range []struct {
+		name string
+		n    int
+		drop bool // Drop the second tick.
+		want time.Time
+	}{
+		{
+			name: "first",
+			n:    1,
+			want: first,
+		},
+		{
+			name: "third",
+			n:    3,
+			want: first.Add(2 * interval),
+		},
+		{
+			name: "thirdWithDrops",
+			n:    3,
+			drop: true,
+			want: first.Add(3 * interval),
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			fc := newFakeClock(first.Add(-time.Second))
+			st := New(first, interval, WithClock(fc))
+			defer st.Stop()
+
+			type result struct {
+				tick time.Time
+				err  error
+			}
+			res := make(chan result, 1)
+			go func() {
+				tick, err := st.WaitForTick(context.Background(), tc.n)
+				res <- result{tick, err}
+			}()
+			// Give WaitForTick a chance to start waiting before the first tick.
+			time.Sleep(10 * time.Millisecond)
+
+			ticks := tc.n
+			if tc.drop {
+				ticks++
+			}
+			for i := 0; i < ticks; i++ {
+				next := first.Add(time.Duration(i) * interval)
+				fc.expectTimer(t, next)
+				fc.Set(next)
+				if tc.drop && i == 0 {
+					// Leave the first tick in C so that the second one is dropped.
+					continue
+				}
+				fc.expectTimer(t, next.Add(interval))
+				receive(t, st.C)
+			}
+
+			r := receive(t, res)
+			if r.err != nil {
+				t.Fatalf("unexpected error: %v", r.err)
+			}
+			if !r.tick.Equal(tc.want) {
+				t.Errorf("expected tick at %v, but got %v", tc.want, r.tick)
+			}
+		})
+	}
+}
+
+func TestWaitForTickCancel(t *testing.T) {
+	st := New(time.Now().Add(time.Hour), time.Minute)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := st.WaitForTick(ctx, 1); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected %v, but got %v", context.DeadlineExceeded, err)
+	}
+
+	time.AfterFunc(10*time.Millisecond, st.Stop)
+	if _, err := st.WaitForTick(context.Background(), 1); !errors.Is(err, ErrStopped) {
+		t.Errorf("expected %v, but got %v", ErrStopped, err)
+	}
+}
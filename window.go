@@ -0,0 +1,27 @@
+package sticker
+
+import "time"
+
+// WithWindowStart makes the ticker deliver the start of the window that a tick ends instead of the time
+// the tick fired, e.g. for aggregation jobs that process the window that just ended. For a regular schedule
+// the window of a tick starts one interval before it, so the first tick after startup fires at the next
+// point in time of the schedule and delivers the previous one as calculated by [PreviousRun]. This affects
+// the times delivered on C and to subscribers but not the [Tick] of a DetailedTicker.
+func WithWindowStart() Option {
+	return func(st *ScheduledTicker) {
+		st.windowStart = true
+	}
+}
+
+// windowOf returns the start of the window that ends with the tick at scheduled. st.mu must be held.
+func (st *ScheduledTicker) windowOf(scheduled time.Time) time.Time {
+	if st.sched == nil {
+		return scheduled.Add(-st.interval)
+	}
+	if r, ok := st.sched.(reversibleSchedule); ok {
+		if prev := r.prev(scheduled.Add(-time.Nanosecond)); !prev.IsZero() {
+			return prev
+		}
+	}
+	return scheduled
+}
@@ -0,0 +1,47 @@
+package sticker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWindowStart(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 7, 30, 0, time.UTC)
+	interval := 15 * time.Minute
+	anchor := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeClock(now)
+	st := New(anchor, interval, WithWindowStart(), WithClock(fc))
+	defer st.Stop()
+	sub := st.Subscribe()
+
+	windowStart := PreviousRun(anchor, interval, now)
+	next := windowStart.Add(interval)
+	fc.expectTimer(t, next)
+	fc.Set(next)
+	if tick := receive(t, st.C); !tick.Equal(windowStart) {
+		t.Errorf("expected window start %v, but got %v", windowStart, tick)
+	}
+	if tick := receive(t, sub); !tick.Equal(windowStart) {
+		t.Errorf("expected window start %v for subscriber, but got %v", windowStart, tick)
+	}
+
+	fc.expectTimer(t, next.Add(interval))
+	fc.Set(next.Add(interval))
+	if tick := receive(t, st.C); !tick.Equal(next) {
+		t.Errorf("expected window start %v, but got %v", next, tick)
+	}
+}
+
+func TestWindowStartDaily(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	fc := newFakeClock(now)
+	st := NewEveryDay(6*time.Hour, time.UTC, WithWindowStart(), WithClock(fc))
+	defer st.Stop()
+
+	next := time.Date(2023, 6, 2, 6, 0, 0, 0, time.UTC)
+	fc.expectTimer(t, next)
+	fc.Set(next)
+	if tick, want := receive(t, st.C), time.Date(2023, 6, 1, 6, 0, 0, 0, time.UTC); !tick.Equal(want) {
+		t.Errorf("expected window start %v, but got %v", want, tick)
+	}
+}